@@ -0,0 +1,138 @@
+package goassert
+
+import (
+	"testing"
+	"time"
+)
+
+/*
+Asserts that a value is received from the given channel within the given timeout and returns it.
+Receiving from a closed channel is a failure, in which case the zero value is returned
+*/
+func ChanReceives[T any](t testing.TB, ch <-chan T, timeout time.Duration) T {
+	t.Helper()
+
+	value, _ := receiveWithin(t, ch, timeout)
+	return value
+}
+
+/*
+Asserts that the expected value is the next value received from the given channel within the given timeout
+*/
+func ChanReceivesValue[T comparable](t testing.TB, ch <-chan T, expected T, timeout time.Duration) {
+	t.Helper()
+
+	value, received := receiveWithin(t, ch, timeout)
+	if received && value != expected {
+		fail(t, "Received an unexpected value from the channel. "+inequalityMsg(expected, value))
+	}
+}
+
+/*
+Asserts that the given channel is closed. The check does not block.
+A closed channel still holding buffered values is reported as not closed, since receivers still get its values.
+The check receives from the channel, so on an unbuffered channel it can take the value of a waiting sender,
+which is reported as a failure
+*/
+func ChanClosed[T any](t testing.TB, ch <-chan T) {
+	t.Helper()
+
+	if length := len(ch); length > 0 {
+		failf(t, "Expected the channel to be closed but it has a length of %d", length)
+		return
+	}
+
+	select {
+	case value, ok := <-ch:
+		if ok {
+			failf(t, "Expected the channel to be closed but received %v from a waiting sender", value)
+		}
+	default:
+		fail(t, "Expected the channel to be closed but it is open")
+	}
+}
+
+/*
+Asserts that the given channel is not closed. The check does not block and does not receive buffered values.
+When the channel holds no buffered values the check receives from it, so on an unbuffered channel it can take
+the value of a waiting sender. The check passes since the channel is open, but that value is consumed and
+never reaches the receivers of the channel
+*/
+func ChanNotClosed[T any](t testing.TB, ch <-chan T) {
+	t.Helper()
+
+	if len(ch) > 0 {
+		return
+	}
+
+	select {
+	case _, ok := <-ch:
+		if !ok {
+			fail(t, "Expected the channel to not be closed but it is closed")
+		}
+	default:
+	}
+}
+
+/*
+Asserts that the given channel holds no buffered values
+*/
+func ChanEmpty[T any](t testing.TB, ch <-chan T) {
+	t.Helper()
+
+	if length := len(ch); length != 0 {
+		failf(t, "Expected the channel to be empty but it has a length of %d", length)
+	}
+}
+
+/*
+Asserts that the given channel holds the expected number of buffered values
+*/
+func ChanLength[T any](t testing.TB, ch <-chan T, expectedLength int) {
+	t.Helper()
+
+	if length := len(ch); length != expectedLength {
+		failf(t, "Expected the channel to have length of %d but got %d", expectedLength, length)
+	}
+}
+
+/*
+Asserts that no value is received from the given channel during the given duration.
+A channel that is closed during the duration is a failure, since receiving from it does not block
+*/
+func ChanNeverReceives[T any](t testing.TB, ch <-chan T, duration time.Duration) {
+	t.Helper()
+
+	start := time.Now()
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case value, ok := <-ch:
+		if !ok {
+			failf(t, "Expected no value to be received during %v but the channel was closed after %v", duration, time.Since(start))
+			return
+		}
+		failf(t, "Expected no value to be received during %v but received %v after %v", duration, value, time.Since(start))
+	case <-timer.C:
+	}
+}
+
+func receiveWithin[T any](t testing.TB, ch <-chan T, timeout time.Duration) (T, bool) {
+	t.Helper()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case value, ok := <-ch:
+		if !ok {
+			fail(t, "Expected to receive a value but the channel was closed")
+		}
+		return value, ok
+	case <-timer.C:
+		failf(t, "Expected to receive a value within %v but nothing was received", timeout)
+		var zero T
+		return zero, false
+	}
+}
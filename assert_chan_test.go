@@ -0,0 +1,199 @@
+package goassert
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_ChanReceivesShouldReturnValue_WhenValueIsSentWithinTimeout(t *testing.T) {
+	tester := new(testing.T)
+	ch := make(chan int)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		ch <- 42
+	}()
+
+	value := ChanReceives(tester, ch, time.Second)
+
+	if tester.Failed() || value != 42 {
+		t.Errorf("ChanReceives did not return the received value but got %d", value)
+	}
+}
+
+func Test_ChanReceivesShouldFail_WhenNothingIsSentWithinTimeout(t *testing.T) {
+	tester := newRecordingT()
+
+	ChanReceives(tester, make(chan int), 20*time.Millisecond)
+
+	if tester.output() != "Expected to receive a value within 20ms but nothing was received" {
+		t.Errorf("ChanReceives did not report the timeout but got %q", tester.output())
+	}
+}
+
+func Test_ChanReceivesShouldFail_GivenClosedChannel(t *testing.T) {
+	tester := newRecordingT()
+	ch := make(chan int)
+	close(ch)
+
+	ChanReceives(tester, ch, time.Second)
+
+	if tester.output() != "Expected to receive a value but the channel was closed" {
+		t.Errorf("ChanReceives did not report the closed channel but got %q", tester.output())
+	}
+}
+
+func Test_ChanReceivesValueShouldPass_WhenExpectedValueIsReceived(t *testing.T) {
+	tester := new(testing.T)
+	ch := make(chan string, 1)
+	ch <- "done"
+
+	ChanReceivesValue(tester, ch, "done", time.Second)
+
+	if tester.Failed() {
+		t.Error("ChanReceivesValue did not pass when the expected value was received")
+	}
+}
+
+func Test_ChanReceivesValueShouldFail_WhenDifferentValueIsReceived(t *testing.T) {
+	tester := newRecordingT()
+	ch := make(chan string, 1)
+	ch <- "failed"
+
+	ChanReceivesValue(tester, ch, "done", time.Second)
+
+	if tester.output() != "Received an unexpected value from the channel. Expected: done. Actual: failed" {
+		t.Errorf("ChanReceivesValue did not report the unexpected value but got %q", tester.output())
+	}
+}
+
+func Test_ChanClosedShouldPass_GivenClosedChannel(t *testing.T) {
+	tester := new(testing.T)
+	ch := make(chan int)
+	close(ch)
+
+	ChanClosed(tester, ch)
+
+	if tester.Failed() {
+		t.Error("ChanClosed did not pass when the channel was closed")
+	}
+}
+
+func Test_ChanClosedShouldFail_GivenOpenChannel(t *testing.T) {
+	tester := new(testing.T)
+
+	ChanClosed(tester, make(chan int))
+
+	if !tester.Failed() {
+		t.Error("ChanClosed did not fail when the channel was open")
+	}
+}
+
+func Test_ChanClosedShouldFail_GivenClosedChannelWithBufferedValues(t *testing.T) {
+	tester := newRecordingT()
+	ch := make(chan int, 2)
+	ch <- 1
+	close(ch)
+
+	ChanClosed(tester, ch)
+
+	if tester.output() != "Expected the channel to be closed but it has a length of 1" || len(ch) != 1 {
+		t.Errorf("ChanClosed did not report the buffered values without receiving them but got %q", tester.output())
+	}
+}
+
+func Test_ChanNotClosedShouldPass_GivenOpenChannelWithBufferedValues(t *testing.T) {
+	tester := new(testing.T)
+	ch := make(chan int, 1)
+	ch <- 1
+
+	ChanNotClosed(tester, ch)
+
+	if tester.Failed() || len(ch) != 1 {
+		t.Error("ChanNotClosed did not pass without receiving when the channel held buffered values")
+	}
+}
+
+func Test_ChanNotClosedShouldFail_GivenClosedChannel(t *testing.T) {
+	tester := new(testing.T)
+	ch := make(chan int)
+	close(ch)
+
+	ChanNotClosed(tester, ch)
+
+	if !tester.Failed() {
+		t.Error("ChanNotClosed did not fail when the channel was closed")
+	}
+}
+
+func Test_ChanNotClosedShouldPassAndConsumeValue_WhenItReceivesFromWaitingSender(t *testing.T) {
+	ch := make(chan int)
+	sent := make(chan struct{})
+	go func() {
+		ch <- 42
+		close(sent)
+	}()
+
+	// the value is consumed once the sender is waiting when the check receives
+	tester := new(testing.T)
+	deadline := time.Now().Add(time.Second)
+	consumed := false
+	for !consumed && !tester.Failed() && time.Now().Before(deadline) {
+		ChanNotClosed(tester, ch)
+		select {
+		case <-sent:
+			consumed = true
+		default:
+		}
+	}
+
+	if tester.Failed() || !consumed {
+		t.Error("ChanNotClosed did not pass when it received the value of a waiting sender")
+	}
+}
+
+func Test_ChanEmptyShouldFail_GivenChannelWithBufferedValues(t *testing.T) {
+	tester := new(testing.T)
+	ch := make(chan int, 1)
+	ch <- 1
+
+	ChanEmpty(tester, ch)
+
+	if !tester.Failed() {
+		t.Error("ChanEmpty did not fail when the channel held buffered values")
+	}
+}
+
+func Test_ChanLengthShouldPass_GivenChannelWithExpectedLength(t *testing.T) {
+	tester := new(testing.T)
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+
+	ChanLength(tester, ch, 2)
+
+	if tester.Failed() {
+		t.Error("ChanLength did not pass when the channel held the expected number of values")
+	}
+}
+
+func Test_ChanNeverReceivesShouldPass_WhenNothingIsSent(t *testing.T) {
+	tester := new(testing.T)
+
+	ChanNeverReceives(tester, make(chan int), 20*time.Millisecond)
+
+	if tester.Failed() {
+		t.Error("ChanNeverReceives did not pass when nothing was sent")
+	}
+}
+
+func Test_ChanNeverReceivesShouldFail_WhenValueIsSent(t *testing.T) {
+	tester := new(testing.T)
+	ch := make(chan int, 1)
+	ch <- 1
+
+	ChanNeverReceives(tester, ch, time.Second)
+
+	if !tester.Failed() {
+		t.Error("ChanNeverReceives did not fail when a value was sent")
+	}
+}
@@ -0,0 +1,192 @@
+package goassert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+/*
+Asserts that the two given values are deeply equal according to the given options.
+Without options, values are compared like [reflect.DeepEqual], except that function values are never equal unless both are nil.
+On failure, every differing field is reported with its path and the expected and actual values, e.g.
+.Users[1].Address.Zip: expected "5003" but got "5004"
+*/
+func DeepEqualWith[T any](t testing.TB, expected T, actual T, options ...EqualOption) {
+	t.Helper()
+
+	config := equalConfig{}
+	for _, option := range options {
+		option(&config)
+	}
+
+	if len(config.invalidOptions) > 0 {
+		failf(t, "Invalid option: %s", strings.Join(config.invalidOptions, "; "))
+		return
+	}
+
+	differences := diffValues(reflect.ValueOf(&expected).Elem(), reflect.ValueOf(&actual).Elem(), &config)
+	if len(differences) > 0 {
+		fail(t, differencesMsg("Expected and actual are not equal", differences))
+	}
+}
+
+/*
+EqualOption configures how [DeepEqualWith] compares values
+*/
+type EqualOption func(*equalConfig)
+
+/*
+Ignores the struct fields with the given paths. A path is made of field names separated by dots,
+e.g. "Meta.ID", and is relative to the compared value. Slice indexes and map keys are not part of the path,
+so "Users.CreatedAt" ignores the CreatedAt field of every user
+*/
+func IgnoreFields(paths ...string) EqualOption {
+	return func(config *equalConfig) {
+		if config.ignoredFields == nil {
+			config.ignoredFields = make(map[string]bool)
+		}
+		for _, path := range paths {
+			config.ignoredFields[path] = true
+		}
+	}
+}
+
+/*
+Ignores the unexported fields of the given struct types, which are given as values, e.g. IgnoreUnexported(User{}).
+Structs of other types, such as time.Time, are still compared by all their fields
+*/
+func IgnoreUnexported(types ...interface{}) EqualOption {
+	return func(config *equalConfig) {
+		if config.ignoreUnexported == nil {
+			config.ignoreUnexported = make(map[reflect.Type]bool)
+		}
+		for _, value := range types {
+			structType := reflect.TypeOf(value)
+			if structType == nil || structType.Kind() != reflect.Struct {
+				config.invalidOptions = append(config.invalidOptions,
+					fmt.Sprintf("IgnoreUnexported expects struct values but got %s", describeValue(value)))
+				continue
+			}
+			config.ignoreUnexported[structType] = true
+		}
+	}
+}
+
+/*
+Considers nil and empty slices equal, as well as nil and empty maps
+*/
+func EquateEmpty() EqualOption {
+	return func(config *equalConfig) {
+		config.equateEmpty = true
+	}
+}
+
+/*
+Considers floats equal when their difference is at most the given delta, see [InDelta]
+*/
+func EquateApproxFloat(delta float64) EqualOption {
+	return func(config *equalConfig) {
+		config.approxFloats = true
+		config.floatDelta = delta
+	}
+}
+
+/*
+Sorts slices of elements of type T with the given less function before comparing them,
+so that their order is ignored. The indexes of reported differences refer to the sorted slices.
+Slices stored in unexported fields are compared unsorted
+*/
+func SortSlices[T any](less func(a, b T) bool) EqualOption {
+	return func(config *equalConfig) {
+		if config.sorters == nil {
+			config.sorters = make(map[reflect.Type]func(reflect.Value) reflect.Value)
+		}
+		config.sorters[typeOf[T]()] = func(s reflect.Value) reflect.Value {
+			elements := make([]T, s.Len())
+			for i := range elements {
+				elements[i] = valueAs[T](s.Index(i))
+			}
+			sort.SliceStable(elements, func(i, j int) bool {
+				return less(elements[i], elements[j])
+			})
+
+			sorted := reflect.MakeSlice(s.Type(), len(elements), len(elements))
+			for i := range elements {
+				sorted.Index(i).Set(reflect.ValueOf(&elements[i]).Elem())
+			}
+			return sorted
+		}
+	}
+}
+
+/*
+Compares values of type T with the given function instead of comparing their content.
+Values stored in unexported fields are compared by content
+*/
+func Comparer[T any](equal func(a, b T) bool) EqualOption {
+	return func(config *equalConfig) {
+		if config.comparers == nil {
+			config.comparers = make(map[reflect.Type]func(reflect.Value, reflect.Value) bool)
+		}
+		config.comparers[typeOf[T]()] = func(a reflect.Value, b reflect.Value) bool {
+			return equal(valueAs[T](a), valueAs[T](b))
+		}
+	}
+}
+
+type equalConfig struct {
+	ignoredFields      map[string]bool
+	ignoreUnexported   map[reflect.Type]bool
+	ignoreZeroExpected bool
+	equateEmpty        bool
+	approxFloats       bool
+	floatDelta         float64
+	sorters            map[reflect.Type]func(reflect.Value) reflect.Value
+	comparers          map[reflect.Type]func(reflect.Value, reflect.Value) bool
+	invalidOptions     []string
+}
+
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+/*
+Returns the given value as a T. Unlike a type assertion on Interface(), it does not panic on nil interface values
+*/
+func valueAs[T any](v reflect.Value) T {
+	var value T
+	reflect.ValueOf(&value).Elem().Set(v)
+	return value
+}
+
+func differencesMsg(header string, differences []difference) string {
+	var b strings.Builder
+	b.WriteString(header)
+	b.WriteString("\nDifferences:")
+	for i, difference := range differences {
+		if i == maxDiffPaths {
+			fmt.Fprintf(&b, "\n\t... and %d more", len(differences)-maxDiffPaths)
+			break
+		}
+
+		path := difference.path
+		if path == "" {
+			path = "(root)"
+		}
+
+		switch {
+		case !difference.actual.IsValid():
+			fmt.Fprintf(&b, "\n\t%s: missing in actual, expected %s", path, prettyPrintValue(difference.expected, true))
+		case !difference.expected.IsValid():
+			fmt.Fprintf(&b, "\n\t%s: unexpected in actual, got %s", path, prettyPrintValue(difference.actual, true))
+		default:
+			fmt.Fprintf(&b, "\n\t%s: expected %s but got %s",
+				path, prettyPrintValue(difference.expected, true), prettyPrintValue(difference.actual, true))
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,255 @@
+package goassert
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type mockMeta struct {
+	ID      int
+	Version int
+}
+
+type mockRecord struct {
+	ID        int
+	CreatedAt time.Time
+	Tags      []string
+	Score     float64
+	Meta      mockMeta
+	Users     []mockUser
+	cache     map[string]int
+}
+
+type mockResult struct {
+	Err error
+}
+
+func errorsEqualByMessage(a, b error) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Error() == b.Error()
+}
+
+func Test_DeepEqualWithShouldPass_GivenEqualValues(t *testing.T) {
+	tester := new(testing.T)
+
+	DeepEqualWith(tester, mockRecord{ID: 1, Tags: []string{"a"}}, mockRecord{ID: 1, Tags: []string{"a"}})
+
+	if tester.Failed() {
+		t.Error("DeepEqualWith did not pass when the values were equal")
+	}
+}
+
+func Test_DeepEqualWithShouldReportPathsAndValues_GivenDifferentValues(t *testing.T) {
+	tester := newRecordingT()
+
+	DeepEqualWith(tester,
+		mockRecord{ID: 1, Tags: []string{"a", "b"}, Meta: mockMeta{Version: 1}},
+		mockRecord{ID: 1, Tags: []string{"a"}, Meta: mockMeta{Version: 2}})
+
+	expected := "Expected and actual are not equal\nDifferences:\n" +
+		"\t.Tags[1]: missing in actual, expected \"b\"\n" +
+		"\t.Meta.Version: expected 1 but got 2"
+	if tester.output() != expected {
+		t.Errorf("DeepEqualWith did not report the paths and values but got:\n%s", tester.output())
+	}
+}
+
+func Test_DeepEqualWithShouldPass_GivenIgnoredFields(t *testing.T) {
+	tester := new(testing.T)
+
+	DeepEqualWith(tester,
+		mockRecord{ID: 1, CreatedAt: time.Unix(1, 0), Meta: mockMeta{ID: 1, Version: 3}},
+		mockRecord{ID: 2, CreatedAt: time.Unix(2, 0), Meta: mockMeta{ID: 2, Version: 3}},
+		IgnoreFields("ID", "CreatedAt", "Meta.ID"))
+
+	if tester.Failed() {
+		t.Error("DeepEqualWith did not pass when only ignored fields differed")
+	}
+}
+
+func Test_DeepEqualWithShouldIgnoreFieldsOfEveryElement_GivenFieldPathThroughSlice(t *testing.T) {
+	tester := new(testing.T)
+
+	DeepEqualWith(tester,
+		mockRecord{Users: []mockUser{{Name: "Ann", Address: &mockAddress{Zip: "1"}}, {Name: "Bob"}}},
+		mockRecord{Users: []mockUser{{Name: "Ann", Address: &mockAddress{Zip: "2"}}, {Name: "Bob"}}},
+		IgnoreFields("Users.Address.Zip"))
+
+	if tester.Failed() {
+		t.Error("DeepEqualWith did not ignore the field of every slice element")
+	}
+}
+
+func Test_DeepEqualWithShouldFail_GivenDifferentFieldNotIgnored(t *testing.T) {
+	tester := newRecordingT()
+
+	DeepEqualWith(tester, mockRecord{ID: 1, Meta: mockMeta{Version: 1}}, mockRecord{ID: 2, Meta: mockMeta{Version: 2}}, IgnoreFields("ID"))
+
+	if !strings.Contains(tester.output(), ".Meta.Version") || strings.Contains(tester.output(), ".ID") {
+		t.Errorf("DeepEqualWith did not report only the fields that were not ignored but got:\n%s", tester.output())
+	}
+}
+
+func Test_DeepEqualWithShouldPass_GivenIgnoreUnexportedAndDifferentUnexportedFields(t *testing.T) {
+	tester := new(testing.T)
+
+	DeepEqualWith(tester, mockRecord{cache: map[string]int{"a": 1}}, mockRecord{}, IgnoreUnexported(mockRecord{}))
+
+	if tester.Failed() {
+		t.Error("DeepEqualWith did not ignore the unexported fields")
+	}
+}
+
+func Test_DeepEqualWithShouldFail_GivenIgnoreUnexportedOfOtherTypeAndDifferentTimes(t *testing.T) {
+	tester := new(testing.T)
+
+	createdAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	DeepEqualWith(tester, mockRecord{CreatedAt: createdAt}, mockRecord{CreatedAt: createdAt.Add(time.Hour)}, IgnoreUnexported(mockRecord{}))
+
+	if !tester.Failed() {
+		t.Error("DeepEqualWith ignored the unexported fields of time.Time when they were not requested")
+	}
+}
+
+func Test_DeepEqualWithShouldFail_GivenIgnoreUnexportedOfNonStructValue(t *testing.T) {
+	tester := newRecordingT()
+
+	DeepEqualWith(tester, mockRecord{}, mockRecord{}, IgnoreUnexported(&mockRecord{}))
+
+	if !strings.Contains(tester.output(), "Invalid option: IgnoreUnexported expects struct values") {
+		t.Errorf("DeepEqualWith did not report the invalid option but got %q", tester.output())
+	}
+}
+
+func Test_DeepEqualWithShouldFail_GivenDifferentUnexportedFields(t *testing.T) {
+	tester := new(testing.T)
+
+	DeepEqualWith(tester, mockRecord{cache: map[string]int{"a": 1}}, mockRecord{})
+
+	if !tester.Failed() {
+		t.Error("DeepEqualWith did not fail when unexported fields differed")
+	}
+}
+
+func Test_DeepEqualWithShouldPass_GivenEquateEmptyAndNilAndEmptySlices(t *testing.T) {
+	tester := new(testing.T)
+
+	DeepEqualWith(tester, mockRecord{Tags: nil}, mockRecord{Tags: []string{}}, EquateEmpty())
+	DeepEqualWith(tester, map[string][]int{"a": {}}, map[string][]int{"a": nil}, EquateEmpty())
+
+	if tester.Failed() {
+		t.Error("DeepEqualWith did not consider nil and empty slices equal with EquateEmpty")
+	}
+}
+
+func Test_DeepEqualWithShouldFail_GivenNilAndEmptySlices(t *testing.T) {
+	tester := new(testing.T)
+
+	DeepEqualWith(tester, mockRecord{Tags: nil}, mockRecord{Tags: []string{}})
+
+	if !tester.Failed() {
+		t.Error("DeepEqualWith did not fail when a nil slice was compared to an empty slice")
+	}
+}
+
+func Test_DeepEqualWithShouldPass_GivenEquateApproxFloatAndCloseFloats(t *testing.T) {
+	tester := new(testing.T)
+
+	DeepEqualWith(tester, mockRecord{Score: 0.3}, mockRecord{Score: 0.30001}, EquateApproxFloat(0.001))
+
+	if tester.Failed() {
+		t.Error("DeepEqualWith did not consider close floats equal with EquateApproxFloat")
+	}
+}
+
+func Test_DeepEqualWithShouldFail_GivenEquateApproxFloatAndDistantFloats(t *testing.T) {
+	tester := new(testing.T)
+
+	DeepEqualWith(tester, mockRecord{Score: 0.3}, mockRecord{Score: 0.4}, EquateApproxFloat(0.001))
+
+	if !tester.Failed() {
+		t.Error("DeepEqualWith did not fail when floats differed by more than the delta")
+	}
+}
+
+func Test_DeepEqualWithShouldPass_GivenSortSlicesAndSlicesInDifferentOrder(t *testing.T) {
+	tester := new(testing.T)
+
+	DeepEqualWith(tester,
+		mockRecord{Tags: []string{"b", "a", "c"}},
+		mockRecord{Tags: []string{"c", "b", "a"}},
+		SortSlices(func(a, b string) bool { return a < b }))
+
+	if tester.Failed() {
+		t.Error("DeepEqualWith did not ignore the order of the slices with SortSlices")
+	}
+}
+
+func Test_DeepEqualWithShouldNotModifySlices_GivenSortSlices(t *testing.T) {
+	tags := []string{"b", "a"}
+
+	DeepEqualWith(new(testing.T), tags, []string{"a", "b"}, SortSlices(func(a, b string) bool { return a < b }))
+
+	if tags[0] != "b" || tags[1] != "a" {
+		t.Errorf("DeepEqualWith modified the compared slice: %v", tags)
+	}
+}
+
+func Test_DeepEqualWithShouldUseComparer_GivenComparerForType(t *testing.T) {
+	tester := new(testing.T)
+
+	DeepEqualWith(tester,
+		mockRecord{CreatedAt: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)},
+		mockRecord{CreatedAt: time.Date(2024, 1, 1, 13, 0, 0, 0, time.FixedZone("CET", 3600))},
+		Comparer(func(a, b time.Time) bool { return a.Equal(b) }))
+
+	if tester.Failed() {
+		t.Error("DeepEqualWith did not use the comparer for the type")
+	}
+}
+
+func Test_DeepEqualWithShouldPass_GivenSortSlicesAndSlicesHoldingNilInterfaces(t *testing.T) {
+	tester := new(testing.T)
+	failure := errors.New("failure")
+
+	DeepEqualWith(tester, []error{nil, failure}, []error{failure, nil},
+		SortSlices(func(a, b error) bool { return a == nil && b != nil }))
+
+	if tester.Failed() {
+		t.Error("DeepEqualWith did not sort the slices holding nil interfaces with SortSlices")
+	}
+}
+
+func Test_DeepEqualWithShouldPass_GivenComparerAndNilInterfaceFields(t *testing.T) {
+	tester := new(testing.T)
+
+	DeepEqualWith(tester, mockResult{}, mockResult{}, Comparer(errorsEqualByMessage))
+
+	if tester.Failed() {
+		t.Error("DeepEqualWith did not pass the nil interface fields to the comparer")
+	}
+}
+
+func Test_DeepEqualWithShouldFail_GivenComparerAndNilInterfaceFieldComparedToError(t *testing.T) {
+	tester := new(testing.T)
+
+	DeepEqualWith(tester, mockResult{}, mockResult{Err: errors.New("failure")}, Comparer(errorsEqualByMessage))
+
+	if !tester.Failed() {
+		t.Error("DeepEqualWith did not fail when the comparer reported the nil and non nil errors as different")
+	}
+}
+
+func Test_DeepEqualWithShouldReportUnexpectedMapKeys_GivenMapsWithDifferentKeys(t *testing.T) {
+	tester := newRecordingT()
+
+	DeepEqualWith(tester, map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2})
+
+	if !strings.Contains(tester.output(), `["b"]: unexpected in actual, got 2`) {
+		t.Errorf("DeepEqualWith did not report the unexpected key but got:\n%s", tester.output())
+	}
+}
@@ -1,7 +1,9 @@
 package goassert
 
 import (
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -12,7 +14,7 @@ func Equal[K comparable](t testing.TB, expected K, actual K) {
 	t.Helper()
 
 	if actual != expected {
-		t.Error(inequalityMsg(expected, actual))
+		fail(t, inequalityMsg(expected, actual))
 	}
 }
 
@@ -23,7 +25,7 @@ func NotEqual[K comparable](t testing.TB, expected K, actual K) {
 	t.Helper()
 
 	if actual == expected {
-		t.Error(equalityMsg(expected))
+		fail(t, equalityMsg(expected))
 	}
 }
 
@@ -35,7 +37,7 @@ func DeepEqual[T any](t testing.TB, expected T, actual T) {
 	t.Helper()
 
 	if !reflect.DeepEqual(expected, actual) {
-		t.Error(inequalityMsg(expected, actual))
+		fail(t, inequalityMsg(expected, actual))
 	}
 }
 
@@ -47,7 +49,7 @@ func NotDeepEqual[T any](t testing.TB, expected T, actual T) {
 	t.Helper()
 
 	if reflect.DeepEqual(expected, actual) {
-		t.Error(equalityMsg(expected))
+		fail(t, equalityMsg(expected))
 	}
 }
 
@@ -58,7 +60,7 @@ func Nil(t testing.TB, actual interface{}) {
 	t.Helper()
 
 	if !isNil(actual) {
-		t.Error(inequalityMsg(nil, actual))
+		fail(t, inequalityMsg(nil, actual))
 	}
 }
 
@@ -69,10 +71,143 @@ func NotNil(t testing.TB, actual interface{}) {
 	t.Helper()
 
 	if isNil(actual) {
-		t.Error(equalityMsg("nil"))
+		fail(t, equalityMsg("nil"))
 	}
 }
 
+/*
+Asserts that the given value is the zero value of its type. Internally uses reflect.Value.IsZero,
+except that floats and complex numbers are compared with == so that negative zero is zero.
+A non-nil interface is zero if the value it holds is zero
+*/
+func Zero[T any](t testing.TB, actual T) {
+	t.Helper()
+
+	if !isZeroValue(reflect.ValueOf(&actual).Elem()) {
+		failf(t, "Expected %s to be zero", describeValue(actual))
+	}
+}
+
+/*
+Asserts that the given value is not the zero value of its type, see [Zero]
+*/
+func NotZero[T any](t testing.TB, actual T) {
+	t.Helper()
+
+	if isZeroValue(reflect.ValueOf(&actual).Elem()) {
+		failf(t, "Expected a non-zero value of type %v", typeOf[T]())
+	}
+}
+
+/*
+Asserts that the given error is nil
+*/
+func NoError(t testing.TB, err error) {
+	t.Helper()
+
+	if err != nil {
+		failf(t, "Expected no error but got: %v\n%s", err, errorChainMsg(err))
+	}
+}
+
+/*
+Asserts that the given error is not nil
+*/
+func Error(t testing.TB, err error) {
+	t.Helper()
+
+	if err == nil {
+		fail(t, "Expected an error but got nil")
+	}
+}
+
+/*
+Asserts that the given error or any error in its chain matches the target error. Internally uses errors.Is
+*/
+func ErrorIs(t testing.TB, err error, target error) {
+	t.Helper()
+
+	if err == nil {
+		failf(t, "Expected error matching %v but got nil", target)
+		return
+	}
+
+	if !errors.Is(err, target) {
+		failf(t, "Expected error chain to contain %v but it did not\n%s", target, errorChainMsg(err))
+	}
+}
+
+/*
+Asserts that the given error or any error in its chain can be assigned to type T and returns the matching error.
+Internally uses errors.As, so T must be an interface or a type implementing error
+*/
+func ErrorAs[T any](t testing.TB, err error) T {
+	t.Helper()
+
+	var target T
+	targetType := typeOf[T]()
+	if targetType.Kind() != reflect.Interface && !targetType.Implements(typeOf[error]()) {
+		failf(t, "Expected an interface or a type implementing error but %s does not implement error", targetType)
+		return target
+	}
+
+	if err == nil {
+		failf(t, "Expected error of type %s but got nil", targetType)
+		return target
+	}
+
+	if !errors.As(err, &target) {
+		failf(t, "Expected error chain to contain an error of type %s but it did not\n%s", targetType, errorChainMsg(err))
+	}
+
+	return target
+}
+
+/*
+Asserts that the message of the given error contains the given substring
+*/
+func ErrorContains(t testing.TB, err error, substring string) {
+	t.Helper()
+
+	if err == nil {
+		failf(t, "Expected error containing %q but got nil", substring)
+		return
+	}
+
+	if !strings.Contains(err.Error(), substring) {
+		failf(t, "Expected error message to contain %q but got %q\n%s", substring, err.Error(), errorChainMsg(err))
+	}
+}
+
+/*
+Asserts that the message of the given error equals the given message
+*/
+func ErrorMessage(t testing.TB, err error, expectedMessage string) {
+	t.Helper()
+
+	if err == nil {
+		failf(t, "Expected error with message %q but got nil", expectedMessage)
+		return
+	}
+
+	if err.Error() != expectedMessage {
+		failf(t, "Expected error message %q but got %q\n%s", expectedMessage, err.Error(), errorChainMsg(err))
+	}
+}
+
+func isZeroValue(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Interface:
+		return value.IsNil() || isZeroValue(value.Elem())
+	case reflect.Float32, reflect.Float64:
+		return value.Float() == 0
+	case reflect.Complex64, reflect.Complex128:
+		return value.Complex() == 0
+	}
+
+	return value.IsZero()
+}
+
 func isNil(value interface{}) bool {
 	if value == nil {
 		return true
@@ -87,47 +222,158 @@ func isNil(value interface{}) bool {
 }
 
 /*
-Asserts that the two given slices have the same values in any order. The elements must be [comparable]
+Asserts that the two given slices have the same values in any order. Values are compared with reflect.DeepEqual.
+On failure, the elements missing from actual and the extra elements in actual are listed with their multiplicities
 */
 func SimilarSlice[T any](t testing.TB, expected []T, actual []T) {
 	t.Helper()
 
-	if !areSimilarSlices(expected, actual) {
-		t.Error(inequalityMsg(expected, actual))
+	missing, extra := similarSliceDifferences(expected, actual)
+	if len(missing) > 0 || len(extra) > 0 {
+		fail(t, similarSliceMsg(missing, extra))
 	}
 }
 
 /*
-Asserts that the two given slices does not have the same values. The elements must be [comparable]
+Asserts that the two given slices do not have the same values. Values are compared with reflect.DeepEqual
 */
 func NotSimilarSlice[T any](t testing.TB, expected []T, actual []T) {
 	t.Helper()
 
 	if areSimilarSlices(expected, actual) {
-		t.Error(equalityMsg(expected))
+		fail(t, equalityMsg(expected))
 	}
 }
 
 func areSimilarSlices[T any](expected []T, actual []T) bool {
-	expectedLength := len(expected)
-	actualLength := len(actual)
-	if actualLength != expectedLength {
+	if len(expected) != len(actual) {
 		return false
 	}
 
-	matchedIndexMap := make(map[int]interface{}, expectedLength)
+	missing, extra := similarSliceDifferences(expected, actual)
+	return len(missing) == 0 && len(extra) == 0
+}
+
+type elementCount[T any] struct {
+	value T
+	count int
+}
+
+/*
+Returns the elements of expected that have no match in actual and the elements of actual that have no match in expected,
+in the order of their first occurrence. Elements whose type can be used as a map key without changing the
+semantics of reflect.DeepEqual are counted in linear time. Other elements are matched pairwise with reflect.DeepEqual
+*/
+func similarSliceDifferences[T any](expected []T, actual []T) ([]elementCount[T], []elementCount[T]) {
+	if isHashable(reflect.TypeOf((*T)(nil)).Elem()) {
+		return hashedSliceDifferences(expected, actual)
+	}
+
+	return deepSliceDifferences(expected, actual)
+}
+
+func hashedSliceDifferences[T any](expected []T, actual []T) ([]elementCount[T], []elementCount[T]) {
+	remaining := make(map[interface{}]int, len(actual))
+	for _, v := range actual {
+		remaining[v]++
+	}
+
+	var missing []elementCount[T]
+	missingIndexes := make(map[interface{}]int)
+	for _, v := range expected {
+		if remaining[v] > 0 {
+			remaining[v]--
+			continue
+		}
+
+		if i, found := missingIndexes[v]; found {
+			missing[i].count++
+			continue
+		}
+		missingIndexes[v] = len(missing)
+		missing = append(missing, elementCount[T]{value: v, count: 1})
+	}
+
+	var extra []elementCount[T]
+	for _, v := range actual {
+		count, found := remaining[v]
+		if !found {
+			// values that are not equal to themselves, such as NaN, can never be matched
+			extra = append(extra, elementCount[T]{value: v, count: 1})
+			continue
+		}
+
+		if count > 0 {
+			extra = append(extra, elementCount[T]{value: v, count: count})
+			remaining[v] = 0
+		}
+	}
+
+	return missing, extra
+}
+
+func deepSliceDifferences[T any](expected []T, actual []T) ([]elementCount[T], []elementCount[T]) {
+	matched := make([]bool, len(actual))
+
+	var missing []elementCount[T]
 	for _, expectedValue := range expected {
+		found := false
 		for j, actualValue := range actual {
-			if !reflect.DeepEqual(expectedValue, actualValue) {
-				continue
+			if !matched[j] && reflect.DeepEqual(expectedValue, actualValue) {
+				matched[j] = true
+				found = true
+				break
 			}
+		}
 
-			if _, alreadyMatched := matchedIndexMap[j]; !alreadyMatched {
-				matchedIndexMap[j] = nil
-				break
+		if !found {
+			missing = addElementCount(missing, expectedValue)
+		}
+	}
+
+	var extra []elementCount[T]
+	for j, actualValue := range actual {
+		if !matched[j] {
+			extra = addElementCount(extra, actualValue)
+		}
+	}
+
+	return missing, extra
+}
+
+func addElementCount[T any](counts []elementCount[T], value T) []elementCount[T] {
+	for i := range counts {
+		if reflect.DeepEqual(counts[i].value, value) {
+			counts[i].count++
+			return counts
+		}
+	}
+
+	return append(counts, elementCount[T]{value: value, count: 1})
+}
+
+/*
+Reports whether values of the given type compare the same way with == as with reflect.DeepEqual,
+which makes them safe to count in a map. Pointers and interfaces are excluded since reflect.DeepEqual
+compares what they point to
+*/
+func isHashable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128, reflect.String:
+		return true
+	case reflect.Array:
+		return isHashable(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !isHashable(t.Field(i).Type) {
+				return false
 			}
 		}
+		return true
 	}
 
-	return len(matchedIndexMap) == expectedLength
+	return false
 }
@@ -1,6 +1,11 @@
 package goassert
 
-import "testing"
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
 
 func Test_EqualShouldPass_WhenActualMatchesExpected(t *testing.T) {
 	tester := new(testing.T)
@@ -704,3 +709,319 @@ func Test_NotSimilarSliceShouldFail_WhenActualAndExpectedSlicesHaveSameValues(t
 		t.Error("NotSimilarSlice did not fail given two slices with same values")
 	}
 }
+
+func Test_NoErrorShouldPass_GivenNilError(t *testing.T) {
+	tester := new(testing.T)
+
+	NoError(tester, nil)
+
+	if tester.Failed() {
+		t.Error("NoError did not pass when nil error was given")
+	}
+}
+
+func Test_NoErrorShouldFail_GivenNonNilError(t *testing.T) {
+	tester := new(testing.T)
+
+	NoError(tester, errors.New("Error"))
+
+	if !tester.Failed() {
+		t.Error("NoError did not fail when non-nil error was given")
+	}
+}
+
+func Test_NoErrorShouldReportErrorChain_GivenWrappedErrors(t *testing.T) {
+	tester := newRecordingT()
+
+	cause := &mockError{Code: 16}
+	joined := errors.Join(cause, errors.New("second cause"))
+	NoError(tester, fmt.Errorf("loading config: %w", joined))
+
+	for _, expected := range []string{"*fmt.wrapError", "\t\t*errors.joinError", "\t\t\t*goassert.mockError: \"mock error 16\"", "\t\t\t*errors.errorString: \"second cause\""} {
+		if !strings.Contains(tester.output(), expected) {
+			t.Errorf("NoError did not report %q in the error chain but got:\n%s", expected, tester.output())
+		}
+	}
+}
+
+func Test_ErrorShouldPass_GivenNonNilError(t *testing.T) {
+	tester := new(testing.T)
+
+	Error(tester, errors.New("Error"))
+
+	if tester.Failed() {
+		t.Error("Error did not pass when non-nil error was given")
+	}
+}
+
+func Test_ErrorShouldFail_GivenNilError(t *testing.T) {
+	tester := new(testing.T)
+
+	Error(tester, nil)
+
+	if !tester.Failed() {
+		t.Error("Error did not fail when nil error was given")
+	}
+}
+
+func Test_ErrorIsShouldPass_WhenErrorChainContainsTarget(t *testing.T) {
+	tester := new(testing.T)
+
+	target := errors.New("target")
+	ErrorIs(tester, fmt.Errorf("wrapped: %w", target), target)
+
+	if tester.Failed() {
+		t.Error("ErrorIs did not pass when the error chain contained the target")
+	}
+}
+
+func Test_ErrorIsShouldPass_WhenJoinedErrorContainsTarget(t *testing.T) {
+	tester := new(testing.T)
+
+	target := errors.New("target")
+	ErrorIs(tester, errors.Join(errors.New("other"), target), target)
+
+	if tester.Failed() {
+		t.Error("ErrorIs did not pass when the joined error contained the target")
+	}
+}
+
+func Test_ErrorIsShouldFail_WhenErrorChainDoesNotContainTarget(t *testing.T) {
+	tester := new(testing.T)
+
+	ErrorIs(tester, fmt.Errorf("wrapped: %w", errors.New("other")), errors.New("target"))
+
+	if !tester.Failed() {
+		t.Error("ErrorIs did not fail when the error chain did not contain the target")
+	}
+}
+
+func Test_ErrorIsShouldFail_GivenNilError(t *testing.T) {
+	tester := new(testing.T)
+
+	ErrorIs(tester, nil, errors.New("target"))
+
+	if !tester.Failed() {
+		t.Error("ErrorIs did not fail when nil error was given")
+	}
+}
+
+func Test_ErrorAsShouldPassAndReturnTypedError_WhenErrorChainContainsType(t *testing.T) {
+	tester := new(testing.T)
+
+	actual := ErrorAs[*mockError](tester, fmt.Errorf("wrapped: %w", &mockError{Code: 10}))
+
+	if tester.Failed() {
+		t.Error("ErrorAs did not pass when the error chain contained the given type")
+	}
+	if actual == nil || actual.Code != 10 {
+		t.Errorf("ErrorAs did not return the matching error but returned %v", actual)
+	}
+}
+
+func Test_ErrorAsShouldFail_WhenErrorChainDoesNotContainType(t *testing.T) {
+	tester := new(testing.T)
+
+	actual := ErrorAs[*mockError](tester, fmt.Errorf("wrapped: %w", errors.New("other")))
+
+	if !tester.Failed() {
+		t.Error("ErrorAs did not fail when the error chain did not contain the given type")
+	}
+	if actual != nil {
+		t.Errorf("ErrorAs did not return zero value on failure but returned %v", actual)
+	}
+}
+
+func Test_ErrorAsShouldFailWithoutPanicking_GivenTypeNotImplementingError(t *testing.T) {
+	tester := newRecordingT()
+
+	actual := ErrorAs[mockError](tester, &mockError{Code: 10})
+
+	if !strings.Contains(tester.output(), "goassert.mockError does not implement error") {
+		t.Errorf("ErrorAs did not report the type not implementing error but got %q", tester.output())
+	}
+	if actual != (mockError{}) {
+		t.Errorf("ErrorAs did not return zero value on failure but returned %v", actual)
+	}
+}
+
+func Test_ErrorAsShouldFail_GivenNilError(t *testing.T) {
+	tester := new(testing.T)
+
+	ErrorAs[*mockError](tester, nil)
+
+	if !tester.Failed() {
+		t.Error("ErrorAs did not fail when nil error was given")
+	}
+}
+
+func Test_ErrorContainsShouldPass_WhenErrorMessageContainsSubstring(t *testing.T) {
+	tester := new(testing.T)
+
+	ErrorContains(tester, errors.New("file not found"), "not found")
+
+	if tester.Failed() {
+		t.Error("ErrorContains did not pass when the error message contained the substring")
+	}
+}
+
+func Test_ErrorContainsShouldFail_WhenErrorMessageDoesNotContainSubstring(t *testing.T) {
+	tester := new(testing.T)
+
+	ErrorContains(tester, errors.New("file not found"), "permission denied")
+
+	if !tester.Failed() {
+		t.Error("ErrorContains did not fail when the error message did not contain the substring")
+	}
+}
+
+func Test_ErrorContainsShouldFail_GivenNilError(t *testing.T) {
+	tester := new(testing.T)
+
+	ErrorContains(tester, nil, "not found")
+
+	if !tester.Failed() {
+		t.Error("ErrorContains did not fail when nil error was given")
+	}
+}
+
+func Test_ErrorMessageShouldPass_WhenErrorMessageMatches(t *testing.T) {
+	tester := new(testing.T)
+
+	ErrorMessage(tester, errors.New("file not found"), "file not found")
+
+	if tester.Failed() {
+		t.Error("ErrorMessage did not pass when the error message matched")
+	}
+}
+
+func Test_ErrorMessageShouldFail_WhenErrorMessageDoesNotMatch(t *testing.T) {
+	tester := new(testing.T)
+
+	ErrorMessage(tester, errors.New("file not found"), "not found")
+
+	if !tester.Failed() {
+		t.Error("ErrorMessage did not fail when the error message did not match")
+	}
+}
+
+func Test_ErrorMessageShouldFail_GivenNilError(t *testing.T) {
+	tester := new(testing.T)
+
+	ErrorMessage(tester, nil, "file not found")
+
+	if !tester.Failed() {
+		t.Error("ErrorMessage did not fail when nil error was given")
+	}
+}
+
+func Test_SimilarSliceShouldPass_GivenSlicesOfPointersWithEqualValuesInDifferentOrder(t *testing.T) {
+	tester := new(testing.T)
+
+	expectedSlice := []*mockStruct{newMockStruct(10), newMockStruct(16), newMockStruct(10)}
+	actualSlice := []*mockStruct{newMockStruct(16), newMockStruct(10), newMockStruct(10)}
+
+	SimilarSlice(tester, expectedSlice, actualSlice)
+
+	if tester.Failed() {
+		t.Error("SimilarSlice did not pass when given slices of pointers with equal values in different order")
+	}
+}
+
+func Test_SimilarSliceShouldFail_GivenSlicesOfPointersWithDifferentValues(t *testing.T) {
+	tester := new(testing.T)
+
+	expectedSlice := []*mockStruct{newMockStruct(10), newMockStruct(16)}
+	actualSlice := []*mockStruct{newMockStruct(16), newMockStruct(16)}
+
+	SimilarSlice(tester, expectedSlice, actualSlice)
+
+	if !tester.Failed() {
+		t.Error("SimilarSlice did not fail when given slices of pointers with different values")
+	}
+}
+
+func Test_SimilarSliceShouldReportMissingAndExtraElementsWithMultiplicities(t *testing.T) {
+	tester := newRecordingT()
+
+	SimilarSlice(tester, []int{3, 7, 5, 7, 16}, []int{3, 10, 5, 10, 10})
+
+	for _, expected := range []string{"missing from actual: 7 (x2), 16", "extra in actual: 10 (x3)"} {
+		if !strings.Contains(tester.output(), expected) {
+			t.Errorf("SimilarSlice did not report %q but got %q", expected, tester.output())
+		}
+	}
+}
+
+func Test_SimilarSliceShouldReportMissingAndExtraElements_GivenNonComparableElements(t *testing.T) {
+	tester := newRecordingT()
+
+	SimilarSlice(tester, [][]int{{1}, {2}, {2}}, [][]int{{2}, {3}, {1}})
+
+	for _, expected := range []string{"missing from actual: [2]", "extra in actual: [3]"} {
+		if !strings.Contains(tester.output(), expected) {
+			t.Errorf("SimilarSlice did not report %q but got %q", expected, tester.output())
+		}
+	}
+}
+
+func Test_SimilarSliceShouldPass_GivenLargeSlicesInReverseOrder(t *testing.T) {
+	tester := new(testing.T)
+
+	length := 50000
+	expectedSlice := make([]mockStruct, length)
+	actualSlice := make([]mockStruct, length)
+	for i := 0; i < length; i++ {
+		expectedSlice[i] = mockStruct{Prop: i}
+		actualSlice[length-1-i] = mockStruct{Prop: i}
+	}
+
+	SimilarSlice(tester, expectedSlice, actualSlice)
+
+	if tester.Failed() {
+		t.Error("SimilarSlice did not pass when given large slices in reverse order")
+	}
+}
+
+func Test_ZeroShouldPass_GivenZeroStruct(t *testing.T) {
+	tester := new(testing.T)
+
+	Zero(tester, mockUser{})
+	Zero(tester, -0.0)
+	Zero[*mockUser](tester, nil)
+	Zero[interface{}](tester, 0)
+
+	if tester.Failed() {
+		t.Error("Zero did not pass when given zero values")
+	}
+}
+
+func Test_ZeroShouldReportValue_GivenNonZeroString(t *testing.T) {
+	tester := newRecordingT()
+
+	Zero(tester, "text")
+
+	if tester.output() != `Expected "text" to be zero` {
+		t.Errorf("Zero did not report the value but got %q", tester.output())
+	}
+}
+
+func Test_NotZeroShouldPass_GivenNonZeroStruct(t *testing.T) {
+	tester := new(testing.T)
+
+	NotZero(tester, mockUser{Name: "Ann"})
+
+	if tester.Failed() {
+		t.Error("NotZero did not pass when given a non-zero value")
+	}
+}
+
+func Test_NotZeroShouldReportType_GivenZeroValue(t *testing.T) {
+	tester := newRecordingT()
+
+	NotZero(tester, mockUser{})
+
+	if tester.output() != "Expected a non-zero value of type goassert.mockUser" {
+		t.Errorf("NotZero did not report the type but got %q", tester.output())
+	}
+}
@@ -0,0 +1,181 @@
+package goassert
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+/*
+Asserts that the given condition returns true within the given timeout.
+The condition is checked immediately and then every tick until it is satisfied or the timeout elapses.
+Each check runs on its own goroutine. A check that blocks past the timeout is abandoned after one more tick and the assertion fails,
+so the condition must be safe to keep running concurrently with the rest of the test
+*/
+func Eventually(t testing.TB, condition func() bool, timeout time.Duration, tick time.Duration) {
+	t.Helper()
+
+	if _, satisfied := pollUntil(condition, true, timeout, tick); !satisfied {
+		failf(t, "Expected condition to be satisfied within %v but it was not", timeout)
+	}
+}
+
+/*
+Asserts that the given condition never returns true during the given duration.
+The condition is checked immediately and then every tick until the duration elapses.
+A check still running when the duration elapses is given one more tick to return and is then abandoned, see [Eventually]
+*/
+func Never(t testing.TB, condition func() bool, duration time.Duration, tick time.Duration) {
+	t.Helper()
+
+	if elapsed, satisfied := pollUntil(condition, true, duration, tick); satisfied {
+		failf(t, "Expected condition to never be satisfied during %v but it was satisfied after %v", duration, elapsed)
+	}
+}
+
+/*
+Asserts that the given condition returns true every time it is checked during the given duration.
+The condition is checked immediately and then every tick until the duration elapses.
+A check still running when the duration elapses is given one more tick to return and is then abandoned, see [Eventually]
+*/
+func Consistently(t testing.TB, condition func() bool, duration time.Duration, tick time.Duration) {
+	t.Helper()
+
+	if elapsed, unsatisfied := pollUntil(condition, false, duration, tick); unsatisfied {
+		failf(t, "Expected condition to be satisfied during %v but it was not satisfied after %v", duration, elapsed)
+	}
+}
+
+/*
+Asserts that the assertions run by the given function pass within the given timeout.
+The function is called immediately and then every tick with a fresh [CollectT] on which any goassert assertion can be run.
+Failures are collected instead of being reported, and only the failures of the last attempt are reported once the timeout elapses.
+An attempt still running one tick after the timeout elapses is abandoned and reported with the failures it collected so far
+*/
+func EventuallyWith(t testing.TB, assertions func(c *CollectT), timeout time.Duration, tick time.Duration) {
+	t.Helper()
+
+	// attempts run on the goroutines of pollUntil and may be abandoned while running, hence the atomics
+	var lastAttempt atomic.Pointer[CollectT]
+	_, satisfied := pollUntil(func() bool {
+		attempt := &CollectT{failureCollector: failureCollector{TB: t}}
+		lastAttempt.Store(attempt)
+		attempt.run(func() { assertions(attempt) })
+		attempt.finished.Store(true)
+		return !attempt.Failed()
+	}, true, timeout, tick)
+
+	if satisfied {
+		return
+	}
+
+	attempt := lastAttempt.Load()
+	if attempt == nil {
+		failf(t, "Expected assertions to pass within %v but no attempt started", timeout)
+		return
+	}
+	failf(t, "Expected assertions to pass within %v but the last attempt failed with:%s", timeout, attempt.failuresMsg())
+}
+
+/*
+CollectT is the [testing.TB] handed to the function polled by [EventuallyWith].
+It records the failures of a single attempt instead of reporting them to the test.
+FailNow, Fatal and Fatalf stop the current attempt only
+*/
+type CollectT struct {
+	failureCollector
+
+	finished atomic.Bool
+}
+
+func (c *CollectT) failuresMsg() string {
+	var b strings.Builder
+	if !c.finished.Load() {
+		b.WriteString("\n\t(the attempt was still running when the timeout elapsed)")
+	}
+
+	failures := c.collectedFailures()
+	if len(failures) == 0 && c.finished.Load() {
+		return "\n\t(failed without a message)"
+	}
+
+	for _, failure := range failures {
+		b.WriteString("\n\t")
+		b.WriteString(strings.ReplaceAll(failure, "\n", "\n\t"))
+	}
+
+	return b.String()
+}
+
+/*
+Calls the given condition immediately and then every tick until it returns stopValue or the given duration elapses.
+Returns the time elapsed until the condition returned stopValue and whether it did.
+Each check runs on its own goroutine, so a check that blocks does not hold the caller past the duration:
+a check still running when the duration elapses is given one more tick to return and is then abandoned.
+A panic in a check is raised again on the caller's goroutine
+*/
+func pollUntil(condition func() bool, stopValue bool, duration time.Duration, tick time.Duration) (time.Duration, bool) {
+	start := time.Now()
+	deadline := time.NewTimer(duration)
+	defer deadline.Stop()
+
+	for {
+		check := checkAsync(condition)
+		select {
+		case result := <-check:
+			if result.stops(stopValue) {
+				return time.Since(start), true
+			}
+		case <-deadline.C:
+			return time.Since(start), awaitCheck(check, tick).stops(stopValue)
+		}
+
+		wait := time.NewTimer(tick)
+		select {
+		case <-wait.C:
+		case <-deadline.C:
+			wait.Stop()
+			return time.Since(start), false
+		}
+	}
+}
+
+type checkResult struct {
+	recoveredPanic
+	value     bool
+	abandoned bool
+}
+
+func (r checkResult) stops(stopValue bool) bool {
+	if r.panicked() {
+		panic(r.msg(fmt.Sprintf("Condition panicked: %v", r.recovered)))
+	}
+
+	return !r.abandoned && r.value == stopValue
+}
+
+func awaitCheck(check <-chan checkResult, grace time.Duration) checkResult {
+	timer := time.NewTimer(grace)
+	defer timer.Stop()
+
+	select {
+	case result := <-check:
+		return result
+	case <-timer.C:
+		return checkResult{abandoned: true}
+	}
+}
+
+func checkAsync(condition func() bool) <-chan checkResult {
+	// buffered so that an abandoned check can still deliver its result and exit
+	result := make(chan checkResult, 1)
+	go func() {
+		var value bool
+		p := capturePanic(func() { value = condition() })
+		result <- checkResult{recoveredPanic: p, value: value}
+	}()
+
+	return result
+}
@@ -0,0 +1,212 @@
+package goassert
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_EventuallyShouldPass_WhenConditionIsSatisfiedBeforeTimeout(t *testing.T) {
+	tester := new(testing.T)
+
+	var calls int32
+	Eventually(tester, func() bool {
+		return atomic.AddInt32(&calls, 1) == 3
+	}, time.Second, time.Millisecond)
+
+	if tester.Failed() {
+		t.Error("Eventually did not pass when the condition was satisfied before the timeout")
+	}
+}
+
+func Test_EventuallyShouldFail_WhenConditionIsNotSatisfiedBeforeTimeout(t *testing.T) {
+	tester := new(testing.T)
+
+	Eventually(tester, func() bool { return false }, 20*time.Millisecond, time.Millisecond)
+
+	if !tester.Failed() {
+		t.Error("Eventually did not fail when the condition was not satisfied before the timeout")
+	}
+}
+
+func Test_NeverShouldPass_WhenConditionIsNeverSatisfied(t *testing.T) {
+	tester := new(testing.T)
+
+	Never(tester, func() bool { return false }, 20*time.Millisecond, time.Millisecond)
+
+	if tester.Failed() {
+		t.Error("Never did not pass when the condition was never satisfied")
+	}
+}
+
+func Test_NeverShouldFail_WhenConditionIsSatisfiedDuringDuration(t *testing.T) {
+	tester := new(testing.T)
+
+	var calls int32
+	Never(tester, func() bool {
+		return atomic.AddInt32(&calls, 1) == 3
+	}, time.Second, time.Millisecond)
+
+	if !tester.Failed() {
+		t.Error("Never did not fail when the condition was satisfied during the duration")
+	}
+}
+
+func Test_ConsistentlyShouldPass_WhenConditionIsAlwaysSatisfied(t *testing.T) {
+	tester := new(testing.T)
+
+	Consistently(tester, func() bool { return true }, 20*time.Millisecond, time.Millisecond)
+
+	if tester.Failed() {
+		t.Error("Consistently did not pass when the condition was always satisfied")
+	}
+}
+
+func Test_ConsistentlyShouldFail_WhenConditionIsNotSatisfiedDuringDuration(t *testing.T) {
+	tester := new(testing.T)
+
+	var calls int32
+	Consistently(tester, func() bool {
+		return atomic.AddInt32(&calls, 1) < 3
+	}, time.Second, time.Millisecond)
+
+	if !tester.Failed() {
+		t.Error("Consistently did not fail when the condition was not satisfied during the duration")
+	}
+}
+
+func Test_EventuallyWithShouldPass_WhenAssertionsPassBeforeTimeout(t *testing.T) {
+	tester := new(testing.T)
+
+	var attempts atomic.Int32
+	EventuallyWith(tester, func(c *CollectT) {
+		Equal(c, 3, attempts.Add(1))
+	}, time.Second, time.Millisecond)
+
+	if tester.Failed() {
+		t.Error("EventuallyWith did not pass when the assertions passed before the timeout")
+	}
+}
+
+func Test_EventuallyWithShouldReportOnlyLastAttempt_WhenAssertionsDoNotPassBeforeTimeout(t *testing.T) {
+	tester := newRecordingT()
+
+	var attempts atomic.Int32
+	EventuallyWith(tester, func(c *CollectT) {
+		Equal(c, -1, attempts.Add(1))
+	}, 20*time.Millisecond, 5*time.Millisecond)
+
+	lastFailure := fmt.Sprintf("Expected: -1. Actual: %d", attempts.Load())
+	if !tester.Failed() || len(tester.messages) != 1 || !strings.HasSuffix(tester.output(), lastFailure) {
+		t.Errorf("EventuallyWith did not report only the failures of the last attempt but got %q", tester.output())
+	}
+	if strings.Contains(tester.output(), "Actual: 1\n") {
+		t.Errorf("EventuallyWith reported failures of an earlier attempt: %q", tester.output())
+	}
+}
+
+func Test_EventuallyWithShouldStopOnlyCurrentAttempt_WhenFailNowIsCalled(t *testing.T) {
+	tester := new(testing.T)
+
+	var attempts atomic.Int32
+	EventuallyWith(tester, func(c *CollectT) {
+		if attempts.Add(1) < 3 {
+			c.FailNow()
+		}
+	}, time.Second, time.Millisecond)
+
+	if tester.Failed() || attempts.Load() != 3 {
+		t.Errorf("EventuallyWith did not retry after FailNow, attempts: %d", attempts.Load())
+	}
+}
+
+func Test_EventuallyWithShouldRetry_WhenAttemptPanics(t *testing.T) {
+	tester := new(testing.T)
+
+	var attempts atomic.Int32
+	EventuallyWith(tester, func(c *CollectT) {
+		if attempts.Add(1) < 3 {
+			var ready *bool
+			_ = *ready
+		}
+	}, time.Second, time.Millisecond)
+
+	if tester.Failed() || attempts.Load() != 3 {
+		t.Errorf("EventuallyWith did not retry after a panicking attempt, attempts: %d", attempts.Load())
+	}
+}
+
+func Test_EventuallyWithShouldReportPanic_WhenLastAttemptPanics(t *testing.T) {
+	tester := newRecordingT()
+
+	EventuallyWith(tester, func(c *CollectT) {
+		panic("not ready")
+	}, 10*time.Millisecond, time.Millisecond)
+
+	if !strings.Contains(tester.output(), "Unexpected panic: not ready") || !strings.Contains(tester.output(), "Panic stack:") {
+		t.Errorf("EventuallyWith did not report the panic of the last attempt but got:\n%s", tester.output())
+	}
+}
+
+func Test_EventuallyShouldFailOnTime_WhenConditionBlocks(t *testing.T) {
+	tester := new(testing.T)
+	release := make(chan struct{})
+	defer close(release)
+
+	start := time.Now()
+	Eventually(tester, func() bool {
+		<-release
+		return true
+	}, 20*time.Millisecond, time.Millisecond)
+
+	if !tester.Failed() || time.Since(start) > 500*time.Millisecond {
+		t.Errorf("Eventually did not fail on time when the condition blocked, elapsed: %v", time.Since(start))
+	}
+}
+
+func Test_ConsistentlyShouldReturnOnTime_WhenConditionBlocks(t *testing.T) {
+	tester := new(testing.T)
+	release := make(chan struct{})
+	defer close(release)
+
+	start := time.Now()
+	Consistently(tester, func() bool {
+		<-release
+		return false
+	}, 20*time.Millisecond, time.Millisecond)
+
+	if time.Since(start) > 500*time.Millisecond {
+		t.Errorf("Consistently did not return on time when the condition blocked, elapsed: %v", time.Since(start))
+	}
+}
+
+func Test_EventuallyShouldPanicOnCallerGoroutine_WhenConditionPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil || !strings.Contains(fmt.Sprint(r), "Condition panicked: not ready") {
+			t.Errorf("Eventually did not raise the panic of the condition but got %v", r)
+		}
+	}()
+
+	Eventually(new(testing.T), func() bool {
+		panic("not ready")
+	}, time.Second, time.Millisecond)
+}
+
+func Test_EventuallyWithShouldReportRunningAttempt_WhenAttemptBlocks(t *testing.T) {
+	tester := newRecordingT()
+	release := make(chan struct{})
+	defer close(release)
+
+	EventuallyWith(tester, func(c *CollectT) {
+		Equal(c, 1, 2)
+		<-release
+	}, 20*time.Millisecond, time.Millisecond)
+
+	for _, expected := range []string{"(the attempt was still running when the timeout elapsed)", "Expected: 1. Actual: 2"} {
+		if !strings.Contains(tester.output(), expected) {
+			t.Errorf("EventuallyWith did not report %q but got:\n%s", expected, tester.output())
+		}
+	}
+}
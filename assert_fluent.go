@@ -0,0 +1,546 @@
+package goassert
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+/*
+Returns assertions bound to the given test, so that it does not have to be passed to every call:
+
+	a := goassert.New(t)
+	a.NoError(err)
+	a.StringContains(body, "ok")
+
+Every method delegates to the package function of the same name.
+Generic assertions are reached through typed helpers such as [ThatSlice], [ThatComparableSlice], [ThatMap] and [ThatNumber]
+*/
+func New(t testing.TB) *Assertions {
+	return &Assertions{t: t}
+}
+
+/*
+Assertions are bound to a test by [New]
+*/
+type Assertions struct {
+	t testing.TB
+}
+
+/*
+Returns the test the assertions report to, including the prefix and fatal mode of the assertions.
+Useful to call package functions that have no method, e.g. goassert.ErrorAs[*MyError](a.T(), err)
+*/
+func (a *Assertions) T() testing.TB {
+	return a.t
+}
+
+/*
+Returns assertions whose failure messages are prefixed with the formatted message, see [With]
+*/
+func (a *Assertions) With(format string, args ...interface{}) *Assertions {
+	return &Assertions{t: With(a.t, format, args...)}
+}
+
+/*
+Returns assertions that stop the test on failure, like the functions of the require package
+*/
+func (a *Assertions) Require() *Assertions {
+	return &Assertions{t: fatalT{TB: a.t}}
+}
+
+/*
+Asserts that the given values are equal with ==. Both values must have the same dynamic type to be equal.
+Values of uncomparable types, such as slices and maps, are reported as a failure and should be asserted with [Assertions.DeepEqual].
+Use [ThatValue] to keep the type safety of [Equal]
+*/
+func (a *Assertions) Equal(expected interface{}, actual interface{}) {
+	a.t.Helper()
+
+	equal, comparable := interfacesEqual(expected, actual)
+	if !comparable {
+		failf(a.t, "Values of type %T and %T cannot be compared with ==, use DeepEqual instead", expected, actual)
+		return
+	}
+	if !equal {
+		fail(a.t, inequalityMsg(expected, actual))
+	}
+}
+
+/*
+Asserts that the given values are not equal with ==, see [Assertions.Equal]
+*/
+func (a *Assertions) NotEqual(expected interface{}, actual interface{}) {
+	a.t.Helper()
+
+	equal, comparable := interfacesEqual(expected, actual)
+	if !comparable {
+		failf(a.t, "Values of type %T and %T cannot be compared with ==, use NotDeepEqual instead", expected, actual)
+		return
+	}
+	if equal {
+		fail(a.t, equalityMsg(expected))
+	}
+}
+
+/*
+Asserts that the given values are deeply equal, see [DeepEqual]
+*/
+func (a *Assertions) DeepEqual(expected interface{}, actual interface{}) {
+	a.t.Helper()
+	DeepEqual(a.t, expected, actual)
+}
+
+/*
+Asserts that the given values are not deeply equal, see [NotDeepEqual]
+*/
+func (a *Assertions) NotDeepEqual(expected interface{}, actual interface{}) {
+	a.t.Helper()
+	NotDeepEqual(a.t, expected, actual)
+}
+
+/*
+Asserts that the given values are deeply equal according to the given options, see [DeepEqualWith]
+*/
+func (a *Assertions) DeepEqualWith(expected interface{}, actual interface{}, options ...EqualOption) {
+	a.t.Helper()
+	DeepEqualWith(a.t, expected, actual, options...)
+}
+
+/*
+Asserts that the actual value matches the fields that are set in the expected value, see [MatchFields]
+*/
+func (a *Assertions) MatchFields(expected interface{}, actual interface{}) {
+	a.t.Helper()
+	MatchFields(a.t, expected, actual)
+}
+
+/*
+Asserts that the fields of the given value at the given paths equal the expected values, see [MatchMap]
+*/
+func (a *Assertions) MatchMap(expected map[string]interface{}, actual interface{}) {
+	a.t.Helper()
+	MatchMap(a.t, expected, actual)
+}
+
+/*
+Asserts that the given value is nil, see [Nil]
+*/
+func (a *Assertions) Nil(actual interface{}) {
+	a.t.Helper()
+	Nil(a.t, actual)
+}
+
+/*
+Asserts that the given value is not nil, see [NotNil]
+*/
+func (a *Assertions) NotNil(actual interface{}) {
+	a.t.Helper()
+	NotNil(a.t, actual)
+}
+
+/*
+Asserts that the given value is the zero value of its type, see [Zero]
+*/
+func (a *Assertions) Zero(actual interface{}) {
+	a.t.Helper()
+	Zero(a.t, actual)
+}
+
+/*
+Asserts that the given value is not the zero value of its type, see [NotZero]
+*/
+func (a *Assertions) NotZero(actual interface{}) {
+	a.t.Helper()
+	NotZero(a.t, actual)
+}
+
+/*
+Asserts that the given error is nil, see [NoError]
+*/
+func (a *Assertions) NoError(err error) {
+	a.t.Helper()
+	NoError(a.t, err)
+}
+
+/*
+Asserts that the given error is not nil, see [Error]
+*/
+func (a *Assertions) Error(err error) {
+	a.t.Helper()
+	Error(a.t, err)
+}
+
+/*
+Asserts that the given error matches the given target, see [ErrorIs]
+*/
+func (a *Assertions) ErrorIs(err error, target error) {
+	a.t.Helper()
+	ErrorIs(a.t, err, target)
+}
+
+/*
+Asserts that the message of the given error contains the given substring, see [ErrorContains]
+*/
+func (a *Assertions) ErrorContains(err error, substring string) {
+	a.t.Helper()
+	ErrorContains(a.t, err, substring)
+}
+
+/*
+Asserts that the message of the given error equals the given message, see [ErrorMessage]
+*/
+func (a *Assertions) ErrorMessage(err error, expectedMessage string) {
+	a.t.Helper()
+	ErrorMessage(a.t, err, expectedMessage)
+}
+
+/*
+Asserts that the two given values have the same dynamic type, see [SameType]
+*/
+func (a *Assertions) SameType(expected interface{}, actual interface{}) {
+	a.t.Helper()
+	SameType(a.t, expected, actual)
+}
+
+/*
+Asserts that the given value is of the given kind, see [Kind]
+*/
+func (a *Assertions) Kind(value interface{}, expected reflect.Kind) {
+	a.t.Helper()
+	Kind(a.t, value, expected)
+}
+
+/*
+Asserts that the given condition is true, see [True]
+*/
+func (a *Assertions) True(assertion bool) {
+	a.t.Helper()
+	True(a.t, assertion)
+}
+
+/*
+Asserts that the given condition is false, see [False]
+*/
+func (a *Assertions) False(assertion bool) {
+	a.t.Helper()
+	False(a.t, assertion)
+}
+
+/*
+Asserts that the given function panics, see [Panic]
+*/
+func (a *Assertions) Panic(underTest func()) {
+	a.t.Helper()
+	Panic(a.t, underTest)
+}
+
+/*
+Asserts that the given function does not panic, see [NotPanic]
+*/
+func (a *Assertions) NotPanic(underTest func()) {
+	a.t.Helper()
+	NotPanic(a.t, underTest)
+}
+
+/*
+Asserts that the given function panics with the given error, see [PanicWithError]
+*/
+func (a *Assertions) PanicWithError(expectedError interface{}, underTest func()) {
+	a.t.Helper()
+	PanicWithError(a.t, expectedError, underTest)
+}
+
+/*
+Asserts that the given function does not panic with the given error, see [NotPanicWithError]
+*/
+func (a *Assertions) NotPanicWithError(expectedError interface{}, underTest func()) {
+	a.t.Helper()
+	NotPanicWithError(a.t, expectedError, underTest)
+}
+
+/*
+Asserts that the given function panics with a message containing the given substring, see [PanicsWithMessage]
+*/
+func (a *Assertions) PanicsWithMessage(substring string, underTest func()) {
+	a.t.Helper()
+	PanicsWithMessage(a.t, substring, underTest)
+}
+
+/*
+Asserts that the given function panics with an error matching the given target, see [PanicsWithErrorIs]
+*/
+func (a *Assertions) PanicsWithErrorIs(target error, underTest func()) {
+	a.t.Helper()
+	PanicsWithErrorIs(a.t, target, underTest)
+}
+
+/*
+Asserts that the given function panics with a matching value, see [PanicsMatching]
+*/
+func (a *Assertions) PanicsMatching(matches func(recovered interface{}) bool, underTest func()) {
+	a.t.Helper()
+	PanicsMatching(a.t, matches, underTest)
+}
+
+/*
+Asserts that the given function panics and returns the recovered value, see [RecoverPanic]
+*/
+func (a *Assertions) RecoverPanic(underTest func()) interface{} {
+	a.t.Helper()
+	return RecoverPanic(a.t, underTest)
+}
+
+/*
+Asserts that the given string contains the given substring, see [StringContains]
+*/
+func (a *Assertions) StringContains(s string, substring string) {
+	a.t.Helper()
+	StringContains(a.t, s, substring)
+}
+
+/*
+Asserts that the given string does not contain the given substring, see [StringNotContains]
+*/
+func (a *Assertions) StringNotContains(s string, substring string) {
+	a.t.Helper()
+	StringNotContains(a.t, s, substring)
+}
+
+/*
+Asserts that the given string starts with the given prefix, see [HasPrefix]
+*/
+func (a *Assertions) HasPrefix(s string, prefix string) {
+	a.t.Helper()
+	HasPrefix(a.t, s, prefix)
+}
+
+/*
+Asserts that the given string ends with the given suffix, see [HasSuffix]
+*/
+func (a *Assertions) HasSuffix(s string, suffix string) {
+	a.t.Helper()
+	HasSuffix(a.t, s, suffix)
+}
+
+/*
+Asserts that the given string matches the given regular expression pattern, see [Regexp]
+*/
+func (a *Assertions) Regexp(s string, pattern string) {
+	a.t.Helper()
+	Regexp(a.t, s, pattern)
+}
+
+/*
+Asserts that the given string does not match the given regular expression pattern, see [NotRegexp]
+*/
+func (a *Assertions) NotRegexp(s string, pattern string) {
+	a.t.Helper()
+	NotRegexp(a.t, s, pattern)
+}
+
+/*
+Asserts that the given strings are equal ignoring case, see [EqualFold]
+*/
+func (a *Assertions) EqualFold(expected string, actual string) {
+	a.t.Helper()
+	EqualFold(a.t, expected, actual)
+}
+
+/*
+Asserts that the given string has the given length in runes, see [StringLength]
+*/
+func (a *Assertions) StringLength(s string, expectedLength int) {
+	a.t.Helper()
+	StringLength(a.t, s, expectedLength)
+}
+
+/*
+Asserts that the given texts are equal after applying the given options, see [EqualText]
+*/
+func (a *Assertions) EqualText(expected string, actual string, options ...TextOption) {
+	a.t.Helper()
+	EqualText(a.t, expected, actual, options...)
+}
+
+/*
+Asserts that the given JSON documents are semantically equal, see [JSONEq]
+*/
+func (a *Assertions) JSONEq(expected string, actual string) {
+	a.t.Helper()
+	JSONEq(a.t, expected, actual)
+}
+
+/*
+Asserts that the actual JSON document contains the expected JSON document, see [JSONContains]
+*/
+func (a *Assertions) JSONContains(expected string, actual string) {
+	a.t.Helper()
+	JSONContains(a.t, expected, actual)
+}
+
+/*
+Asserts that the value at the given path of the given JSON document equals the given value, see [JSONPathEqual]
+*/
+func (a *Assertions) JSONPathEqual(document string, path string, expected interface{}) {
+	a.t.Helper()
+	JSONPathEqual(a.t, document, path, expected)
+}
+
+/*
+Asserts that the given response has the expected status code, see [StatusCode]
+*/
+func (a *Assertions) StatusCode(response *http.Response, expected int) {
+	a.t.Helper()
+	StatusCode(a.t, response, expected)
+}
+
+/*
+Asserts that the first value of the given header of the given response equals the expected value, see [HeaderEqual]
+*/
+func (a *Assertions) HeaderEqual(response *http.Response, name string, expected string) {
+	a.t.Helper()
+	HeaderEqual(a.t, response, name, expected)
+}
+
+/*
+Asserts that a value of the given header of the given response contains the given substring, see [HeaderContains]
+*/
+func (a *Assertions) HeaderContains(response *http.Response, name string, substring string) {
+	a.t.Helper()
+	HeaderContains(a.t, response, name, substring)
+}
+
+/*
+Asserts that the body of the given response equals the expected body, see [BodyEqual]
+*/
+func (a *Assertions) BodyEqual(response *http.Response, expected string) {
+	a.t.Helper()
+	BodyEqual(a.t, response, expected)
+}
+
+/*
+Asserts that the body of the given response is a JSON document semantically equal to the expected one, see [BodyJSONEq]
+*/
+func (a *Assertions) BodyJSONEq(response *http.Response, expected string) {
+	a.t.Helper()
+	BodyJSONEq(a.t, response, expected)
+}
+
+/*
+Asserts that the body of the given response contains the given substring, see [BodyContains]
+*/
+func (a *Assertions) BodyContains(response *http.Response, substring string) {
+	a.t.Helper()
+	BodyContains(a.t, response, substring)
+}
+
+/*
+Asserts that the given handler serves the given request with the expected status code and returns the response, see [HTTPHandlerReturns]
+*/
+func (a *Assertions) HTTPHandlerReturns(handler http.Handler, method string, url string, body io.Reader, expectedStatus int) *http.Response {
+	a.t.Helper()
+	return HTTPHandlerReturns(a.t, handler, method, url, body, expectedStatus)
+}
+
+/*
+Asserts that the content of the given golden file equals the given bytes, see [EqualGoldenFile]
+*/
+func (a *Assertions) EqualGoldenFile(path string, actual []byte) {
+	a.t.Helper()
+	EqualGoldenFile(a.t, path, actual)
+}
+
+/*
+Asserts that the given value matches the snapshot of the current test, see [MatchSnapshot]
+*/
+func (a *Assertions) MatchSnapshot(value interface{}) {
+	a.t.Helper()
+	MatchSnapshot(a.t, value)
+}
+
+/*
+Asserts that the given condition is satisfied within the given timeout, see [Eventually]
+*/
+func (a *Assertions) Eventually(condition func() bool, timeout time.Duration, tick time.Duration) {
+	a.t.Helper()
+	Eventually(a.t, condition, timeout, tick)
+}
+
+/*
+Asserts that the given condition is never satisfied during the given duration, see [Never]
+*/
+func (a *Assertions) Never(condition func() bool, duration time.Duration, tick time.Duration) {
+	a.t.Helper()
+	Never(a.t, condition, duration, tick)
+}
+
+/*
+Asserts that the given condition is satisfied during the given duration, see [Consistently]
+*/
+func (a *Assertions) Consistently(condition func() bool, duration time.Duration, tick time.Duration) {
+	a.t.Helper()
+	Consistently(a.t, condition, duration, tick)
+}
+
+/*
+Asserts that the assertions run by the given function pass within the given timeout, see [EventuallyWith]
+*/
+func (a *Assertions) EventuallyWith(assertions func(c *CollectT), timeout time.Duration, tick time.Duration) {
+	a.t.Helper()
+	EventuallyWith(a.t, assertions, timeout, tick)
+}
+
+/*
+Snapshots the running goroutines and registers a cleanup asserting that none was leaked, see [NoGoroutineLeaks]
+*/
+func (a *Assertions) NoGoroutineLeaks(options ...GoroutineLeakOption) {
+	a.t.Helper()
+	NoGoroutineLeaks(a.t, options...)
+}
+
+/*
+Runs the given assertions as a group reported as a single failure, see [Group]
+*/
+func (a *Assertions) Group(name string, assertions func(a *Asserter)) {
+	a.t.Helper()
+	Group(a.t, name, assertions)
+}
+
+/*
+Compares the two given values with ==, reporting whether they could be compared
+*/
+func interfacesEqual(x interface{}, y interface{}) (equal bool, comparable bool) {
+	defer func() {
+		if recover() != nil {
+			equal, comparable = false, false
+		}
+	}()
+
+	return x == y, true
+}
+
+/*
+Wraps a test so that failures stop it, see [Assertions.Require]
+*/
+type fatalT struct {
+	testing.TB
+}
+
+func (f fatalT) Error(args ...interface{}) {
+	f.TB.Helper()
+	f.report(fmt.Sprint(args...))
+}
+
+func (f fatalT) Errorf(format string, args ...interface{}) {
+	f.TB.Helper()
+	f.report(fmt.Sprintf(format, args...))
+}
+
+func (f fatalT) report(msg string) {
+	f.TB.Helper()
+	f.TB.Fatal(msg)
+}
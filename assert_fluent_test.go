@@ -0,0 +1,310 @@
+package goassert
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func Test_AssertionsShouldPass_WhenAssertionsPass(t *testing.T) {
+	tester := new(testing.T)
+	a := New(tester)
+
+	a.Equal(1, 1)
+	a.DeepEqual([]int{1}, []int{1})
+	a.NoError(nil)
+	a.StringContains("hello world", "world")
+	a.NotPanic(func() {})
+	a.PanicsWithMessage("boom", func() { panic("boom") })
+
+	if tester.Failed() {
+		t.Error("Assertions did not pass when all assertions passed")
+	}
+}
+
+func Test_AssertionsShouldFail_WhenAssertionFails(t *testing.T) {
+	tester := newRecordingT()
+
+	New(tester).ErrorIs(errors.New("other"), &mockError{Code: 404})
+
+	if !tester.Failed() {
+		t.Error("Assertions did not fail when the assertion failed")
+	}
+}
+
+func Test_AssertionsEqualShouldFail_GivenValuesOfDifferentTypes(t *testing.T) {
+	tester := new(testing.T)
+
+	New(tester).Equal(1, int64(1))
+
+	if !tester.Failed() {
+		t.Error("Equal did not fail when the values had different types")
+	}
+}
+
+func Test_AssertionsEqualShouldReportUncomparableValues_GivenSlices(t *testing.T) {
+	tester := newRecordingT()
+
+	New(tester).Equal([]int{1}, []int{1})
+
+	if tester.output() != "Values of type []int and []int cannot be compared with ==, use DeepEqual instead" {
+		t.Errorf("Equal did not report the uncomparable values but got %q", tester.output())
+	}
+}
+
+func Test_AssertionsWithShouldPrefixFailures_GivenPrefix(t *testing.T) {
+	tester := newRecordingT()
+
+	New(tester).With("case %d", 3).Equal(1, 2)
+
+	if tester.output() != "case 3: Expected: 1. Actual: 2" {
+		t.Errorf("With did not prefix the failure but got %q", tester.output())
+	}
+}
+
+func Test_AssertionsRequireShouldStopTest_WhenAssertionFails(t *testing.T) {
+	tester := new(testing.T)
+	completed := false
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		New(tester).With("setup").Require().True(false)
+		completed = true
+	}()
+	<-done
+
+	if !tester.Failed() || completed {
+		t.Error("Require did not stop the test when the assertion failed")
+	}
+}
+
+func Test_AssertionsRequireShouldContinue_WhenAssertionPasses(t *testing.T) {
+	tester := new(testing.T)
+
+	New(tester).Require().True(true)
+
+	if tester.Failed() {
+		t.Error("Require did not continue when the assertion passed")
+	}
+}
+
+func Test_ThatValueShouldFail_GivenDifferentValue(t *testing.T) {
+	tester := newRecordingT()
+
+	ThatValue(New(tester), 2).Equal(1)
+
+	if tester.output() != "Expected: 1. Actual: 2" {
+		t.Errorf("ThatValue did not report the inequality but got %q", tester.output())
+	}
+}
+
+func Test_ThatNumberShouldPass_GivenNumberInDelta(t *testing.T) {
+	tester := new(testing.T)
+	a := New(tester)
+
+	ThatNumber(a, 2.501).InDelta(2.5, 0.01)
+	ThatNumber(a, 3).Between(1, 5)
+	ThatNumber(a, 3).Positive()
+
+	if tester.Failed() {
+		t.Error("ThatNumber did not pass when the number assertions passed")
+	}
+}
+
+func Test_ThatOrderedShouldFail_GivenSmallerValue(t *testing.T) {
+	tester := new(testing.T)
+
+	ThatOrdered(New(tester), "a").Greater("b")
+
+	if !tester.Failed() {
+		t.Error("ThatOrdered did not fail when the value was smaller")
+	}
+}
+
+func Test_ThatSliceShouldPass_WhenSliceAssertionsPass(t *testing.T) {
+	tester := new(testing.T)
+	s := ThatSlice(New(tester), [][]string{{"b"}, {"a", "c"}})
+
+	s.Length(2)
+	s.Similar([][]string{{"a", "c"}, {"b"}})
+	s.ContainsMatch(HasLen[[]string](2))
+	s.NotContainsMatch(HasLen[[]string](3))
+
+	if tester.Failed() {
+		t.Error("ThatSlice did not pass when the slice assertions passed")
+	}
+}
+
+func Test_ThatSliceShouldFail_GivenDifferentSlice(t *testing.T) {
+	tester := new(testing.T)
+
+	ThatSlice(New(tester), []mockUser{{Name: "Ann"}}).Equal([]mockUser{{Name: "Bob"}})
+
+	if !tester.Failed() {
+		t.Error("ThatSlice did not fail when the slices were different")
+	}
+}
+
+func Test_ThatComparableSliceShouldPass_WhenSliceAssertionsPass(t *testing.T) {
+	tester := new(testing.T)
+	s := ThatComparableSlice(New(tester), []int{3, 1, 2})
+
+	s.Contains(2)
+	s.Length(3)
+	s.Similar([]int{1, 2, 3})
+	s.Subset([]int{1, 3})
+	s.ContainsMatch(GreaterThan(2))
+
+	if tester.Failed() {
+		t.Error("ThatComparableSlice did not pass when the slice assertions passed")
+	}
+}
+
+func Test_ThatComparableSliceShouldFail_GivenMissingElement(t *testing.T) {
+	tester := new(testing.T)
+
+	ThatComparableSlice(New(tester), []int{1, 2}).Contains(3)
+
+	if !tester.Failed() {
+		t.Error("ThatComparableSlice did not fail when the element was missing")
+	}
+}
+
+func Test_ThatFloatShouldPass_WhenFloatAssertionsPass(t *testing.T) {
+	tester := new(testing.T)
+	a := New(tester)
+
+	x, y := 0.1, 0.2
+	ThatFloat(a, x+y).WithinULPs(0.3, 1)
+	ThatFloat(a, math.NaN()).EqualFloat(math.NaN())
+	ThatFloat(a, float32(1.5)).InDelta(1.4, 0.2)
+
+	if tester.Failed() {
+		t.Error("ThatFloat did not pass when the float assertions passed")
+	}
+}
+
+func Test_ThatOrderedSliceShouldPass_WhenOrderAssertionsPass(t *testing.T) {
+	tester := new(testing.T)
+	a := New(tester)
+
+	ThatOrderedSlice(a, []string{"a", "b", "b"}).Sorted()
+	ThatOrderedSlice(a, []int{1, 2, 3}).StrictlyIncreasing()
+	ThatOrderedSlice(a, []int{3, 3, 1}).Decreasing()
+	ThatNumberSlice(a, []float64{0.2, 0.8}).InDelta([]float64{0.21, 0.79}, 0.02)
+	ThatSliceBy(a, []mockUser{{Name: "Ann"}, {Name: "Bob"}}, func(u mockUser) string { return u.Name }).Sorted()
+
+	if tester.Failed() {
+		t.Error("ThatOrderedSlice did not pass when the order assertions passed")
+	}
+}
+
+func Test_ThatOrderedSliceShouldFail_GivenUnsortedSlice(t *testing.T) {
+	tester := new(testing.T)
+
+	ThatOrderedSlice(New(tester), []int{1, 3, 2}).Sorted()
+
+	if !tester.Failed() {
+		t.Error("ThatOrderedSlice did not fail when the slice was not sorted")
+	}
+}
+
+func Test_ThatSliceByShouldFail_GivenSliceUnsortedByKey(t *testing.T) {
+	tester := new(testing.T)
+
+	ThatSliceBy(New(tester), []mockUser{{Name: "Bob"}, {Name: "Ann"}}, func(u mockUser) string { return u.Name }).Sorted()
+
+	if !tester.Failed() {
+		t.Error("ThatSliceBy did not fail when the slice was not sorted by key")
+	}
+}
+
+func Test_ThatNumberMapShouldFail_GivenValueOutsideDelta(t *testing.T) {
+	tester := new(testing.T)
+
+	ThatNumberMap(New(tester), map[string]int{"a": 10}).InDelta(map[string]int{"a": 12}, 1)
+
+	if !tester.Failed() {
+		t.Error("ThatNumberMap did not fail when a value was outside delta")
+	}
+}
+
+func Test_ThatMapShouldPass_WhenMapAssertionsPass(t *testing.T) {
+	tester := new(testing.T)
+	m := ThatMap(New(tester), http.Header{"Content-Type": {"application/json"}})
+
+	m.ContainsKey("Content-Type")
+	m.NotContainsKey("Accept")
+	m.ContainsMatch("Content-Type", HasLen[[]string](1))
+	m.KeysEqual(http.Header{"Content-Type": nil})
+
+	if tester.Failed() {
+		t.Error("ThatMap did not pass when the map assertions passed")
+	}
+}
+
+func Test_ThatMapShouldFail_GivenMissingKey(t *testing.T) {
+	tester := new(testing.T)
+
+	ThatMap(New(tester), http.Header{}).ContainsKey("Content-Type")
+
+	if !tester.Failed() {
+		t.Error("ThatMap did not fail when the key was missing")
+	}
+}
+
+func Test_ThatComparableMapShouldPass_WhenMapAssertionsPass(t *testing.T) {
+	tester := new(testing.T)
+	m := ThatComparableMap(New(tester), map[string]int{"a": 1, "b": 2})
+
+	m.Contains("a", 1)
+	m.NotContainsKey("c")
+	m.Subset(map[string]int{"b": 2})
+
+	if tester.Failed() {
+		t.Error("ThatComparableMap did not pass when the map assertions passed")
+	}
+}
+
+func Test_ThatComparableMapShouldFail_GivenDifferentValue(t *testing.T) {
+	tester := new(testing.T)
+
+	ThatComparableMap(New(tester), map[string]int{"a": 1}).Contains("a", 2)
+
+	if !tester.Failed() {
+		t.Error("ThatComparableMap did not fail when the value was different")
+	}
+}
+
+func Test_ThatChanShouldPass_WhenChannelAssertionsPass(t *testing.T) {
+	tester := new(testing.T)
+	ch := make(chan string, 2)
+	ch <- "first"
+	ch <- "second"
+	c := ThatChan(New(tester), ch)
+
+	c.Length(2)
+	c.ReceivesValue("first", time.Second)
+	c.Receives(time.Second)
+	c.Empty()
+	c.NotClosed()
+
+	if tester.Failed() {
+		t.Error("ThatChan did not pass when the channel assertions passed")
+	}
+}
+
+func Test_AssertionsZeroShouldPass_GivenZeroValueInInterface(t *testing.T) {
+	tester := new(testing.T)
+	a := New(tester)
+
+	a.Zero(0)
+	a.NotZero("text")
+
+	if tester.Failed() {
+		t.Error("Zero and NotZero did not look through the interface of the value")
+	}
+}
@@ -0,0 +1,647 @@
+package goassert
+
+import (
+	"testing"
+	"time"
+)
+
+/*
+Returns type-safe assertions on the given value, for the generic assertions that cannot be methods of [Assertions]:
+
+	goassert.ThatValue(a, user.ID).Equal(42)
+*/
+func ThatValue[T comparable](a *Assertions, actual T) *ValueAssertions[T] {
+	return &ValueAssertions[T]{t: a.t, actual: actual}
+}
+
+/*
+ValueAssertions are type-safe assertions on a comparable value, created by [ThatValue]
+*/
+type ValueAssertions[T comparable] struct {
+	t      testing.TB
+	actual T
+}
+
+/*
+Asserts that the value equals the given expected value, see [Equal]
+*/
+func (v *ValueAssertions[T]) Equal(expected T) {
+	v.t.Helper()
+	Equal(v.t, expected, v.actual)
+}
+
+/*
+Asserts that the value does not equal the given value, see [NotEqual]
+*/
+func (v *ValueAssertions[T]) NotEqual(expected T) {
+	v.t.Helper()
+	NotEqual(v.t, expected, v.actual)
+}
+
+/*
+Asserts that the value satisfies the given matcher, see [That]
+*/
+func (v *ValueAssertions[T]) Matches(matcher Matcher[T]) {
+	v.t.Helper()
+	That(v.t, v.actual, matcher)
+}
+
+/*
+Returns type-safe assertions on the given ordered value:
+
+	goassert.ThatOrdered(a, version).Between("1.0", "2.0")
+*/
+func ThatOrdered[T Ordered](a *Assertions, actual T) *OrderedAssertions[T] {
+	return &OrderedAssertions[T]{ValueAssertions: ThatValue(a, actual)}
+}
+
+/*
+OrderedAssertions are type-safe assertions on an ordered value, created by [ThatOrdered]
+*/
+type OrderedAssertions[T Ordered] struct {
+	*ValueAssertions[T]
+}
+
+/*
+Asserts that the value is greater than the given value, see [Greater]
+*/
+func (o *OrderedAssertions[T]) Greater(b T) {
+	o.t.Helper()
+	Greater(o.t, o.actual, b)
+}
+
+/*
+Asserts that the value is greater than or equal to the given value, see [GreaterOrEqual]
+*/
+func (o *OrderedAssertions[T]) GreaterOrEqual(b T) {
+	o.t.Helper()
+	GreaterOrEqual(o.t, o.actual, b)
+}
+
+/*
+Asserts that the value is less than the given value, see [Less]
+*/
+func (o *OrderedAssertions[T]) Less(b T) {
+	o.t.Helper()
+	Less(o.t, o.actual, b)
+}
+
+/*
+Asserts that the value is less than or equal to the given value, see [LessOrEqual]
+*/
+func (o *OrderedAssertions[T]) LessOrEqual(b T) {
+	o.t.Helper()
+	LessOrEqual(o.t, o.actual, b)
+}
+
+/*
+Asserts that the value is within the given inclusive bounds, see [Between]
+*/
+func (o *OrderedAssertions[T]) Between(lower T, upper T) {
+	o.t.Helper()
+	Between(o.t, o.actual, lower, upper)
+}
+
+/*
+Returns type-safe assertions on the given number:
+
+	goassert.ThatNumber(a, average).InDelta(2.5, 0.01)
+*/
+func ThatNumber[N Number](a *Assertions, actual N) *NumberAssertions[N] {
+	return &NumberAssertions[N]{OrderedAssertions: ThatOrdered(a, actual)}
+}
+
+/*
+NumberAssertions are type-safe assertions on a number, created by [ThatNumber]
+*/
+type NumberAssertions[N Number] struct {
+	*OrderedAssertions[N]
+}
+
+/*
+Asserts that the number is within the given delta of the expected number, see [InDelta]
+*/
+func (n *NumberAssertions[N]) InDelta(expected N, delta float64) {
+	n.t.Helper()
+	InDelta(n.t, expected, n.actual, delta)
+}
+
+/*
+Asserts that the relative error between the expected number and the number is at most the given epsilon, see [InEpsilon]
+*/
+func (n *NumberAssertions[N]) InEpsilon(expected N, epsilon float64) {
+	n.t.Helper()
+	InEpsilon(n.t, expected, n.actual, epsilon)
+}
+
+/*
+Asserts that the number is greater than zero, see [Positive]
+*/
+func (n *NumberAssertions[N]) Positive() {
+	n.t.Helper()
+	Positive(n.t, n.actual)
+}
+
+/*
+Asserts that the number is less than zero, see [Negative]
+*/
+func (n *NumberAssertions[N]) Negative() {
+	n.t.Helper()
+	Negative(n.t, n.actual)
+}
+
+/*
+Asserts that the number is zero, see [Zero]
+*/
+func (n *NumberAssertions[N]) Zero() {
+	n.t.Helper()
+	Zero(n.t, n.actual)
+}
+
+/*
+Returns type-safe assertions on the given floating-point number:
+
+	goassert.ThatFloat(a, ratio).WithinULPs(0.3, 4)
+*/
+func ThatFloat[F Float](a *Assertions, actual F) *FloatAssertions[F] {
+	return &FloatAssertions[F]{NumberAssertions: ThatNumber(a, actual)}
+}
+
+/*
+FloatAssertions are type-safe assertions on a floating-point number, created by [ThatFloat]
+*/
+type FloatAssertions[F Float] struct {
+	*NumberAssertions[F]
+}
+
+/*
+Asserts that the number exactly equals the expected number, with NaN equal to NaN, see [EqualFloat]
+*/
+func (f *FloatAssertions[F]) EqualFloat(expected F) {
+	f.t.Helper()
+	EqualFloat(f.t, expected, f.actual)
+}
+
+/*
+Asserts that the number is at most maxULPs units in the last place from the expected number, see [WithinULPs]
+*/
+func (f *FloatAssertions[F]) WithinULPs(expected F, maxULPs uint64) {
+	f.t.Helper()
+	WithinULPs(f.t, expected, f.actual, maxULPs)
+}
+
+/*
+Returns type-safe assertions on the given slice of elements of any type.
+Assertions comparing elements with == are reached through [ThatComparableSlice]:
+
+	goassert.ThatSlice(a, users).Length(2)
+*/
+func ThatSlice[T any](a *Assertions, actual []T) *SliceAssertions[T] {
+	return &SliceAssertions[T]{t: a.t, actual: actual}
+}
+
+/*
+SliceAssertions are type-safe assertions on a slice, created by [ThatSlice]
+*/
+type SliceAssertions[T any] struct {
+	t      testing.TB
+	actual []T
+}
+
+/*
+Asserts that the slice is deeply equal to the expected slice, see [DeepEqual]
+*/
+func (s *SliceAssertions[T]) Equal(expected []T) {
+	s.t.Helper()
+	DeepEqual(s.t, expected, s.actual)
+}
+
+/*
+Asserts that the slice is empty, see [EmptySlice]
+*/
+func (s *SliceAssertions[T]) Empty() {
+	s.t.Helper()
+	EmptySlice(s.t, s.actual)
+}
+
+/*
+Asserts that the slice is not nil or empty, see [NotEmptySlice]
+*/
+func (s *SliceAssertions[T]) NotEmpty() {
+	s.t.Helper()
+	NotEmptySlice(s.t, s.actual)
+}
+
+/*
+Asserts that the slice has the given length, see [SliceLength]
+*/
+func (s *SliceAssertions[T]) Length(expectedLength int) {
+	s.t.Helper()
+	SliceLength(s.t, s.actual, expectedLength)
+}
+
+/*
+Asserts that the slice contains an element satisfying the given matcher, see [SliceContainsMatch]
+*/
+func (s *SliceAssertions[T]) ContainsMatch(matcher Matcher[T]) {
+	s.t.Helper()
+	SliceContainsMatch(s.t, s.actual, matcher)
+}
+
+/*
+Asserts that the slice does not contain any element satisfying the given matcher, see [SliceNotContainsMatch]
+*/
+func (s *SliceAssertions[T]) NotContainsMatch(matcher Matcher[T]) {
+	s.t.Helper()
+	SliceNotContainsMatch(s.t, s.actual, matcher)
+}
+
+/*
+Asserts that the slice has the same elements as the expected slice in any order, see [SimilarSlice]
+*/
+func (s *SliceAssertions[T]) Similar(expected []T) {
+	s.t.Helper()
+	SimilarSlice(s.t, expected, s.actual)
+}
+
+/*
+Asserts that the slice does not have the same elements as the expected slice, see [NotSimilarSlice]
+*/
+func (s *SliceAssertions[T]) NotSimilar(expected []T) {
+	s.t.Helper()
+	NotSimilarSlice(s.t, expected, s.actual)
+}
+
+/*
+Asserts that the slice is sorted according to the given less function, see [SliceSortedFunc]
+*/
+func (s *SliceAssertions[T]) SortedFunc(less func(a, b T) bool) {
+	s.t.Helper()
+	SliceSortedFunc(s.t, s.actual, less)
+}
+
+/*
+Returns type-safe assertions on the given slice of comparable elements:
+
+	goassert.ThatComparableSlice(a, ids).Contains(42)
+*/
+func ThatComparableSlice[T comparable](a *Assertions, actual []T) *ComparableSliceAssertions[T] {
+	return &ComparableSliceAssertions[T]{SliceAssertions: ThatSlice(a, actual)}
+}
+
+/*
+ComparableSliceAssertions are type-safe assertions on a slice of comparable elements, created by [ThatComparableSlice]
+*/
+type ComparableSliceAssertions[T comparable] struct {
+	*SliceAssertions[T]
+}
+
+/*
+Asserts that the slice contains the given element, see [SliceContains]
+*/
+func (c *ComparableSliceAssertions[T]) Contains(element T) {
+	c.t.Helper()
+	SliceContains(c.t, c.actual, element)
+}
+
+/*
+Asserts that the slice does not contain the given element, see [SliceNotContains]
+*/
+func (c *ComparableSliceAssertions[T]) NotContains(element T) {
+	c.t.Helper()
+	SliceNotContains(c.t, c.actual, element)
+}
+
+/*
+Asserts that every element of the expected slice can be found in the slice, see [SliceSubset]
+*/
+func (c *ComparableSliceAssertions[T]) Subset(expected []T) {
+	c.t.Helper()
+	SliceSubset(c.t, expected, c.actual)
+}
+
+/*
+Asserts that every element of the slice can be found in the expected slice, see [SliceSuperset]
+*/
+func (c *ComparableSliceAssertions[T]) Superset(expected []T) {
+	c.t.Helper()
+	SliceSuperset(c.t, expected, c.actual)
+}
+
+/*
+Asserts that the slice has no elements in common with the given slice, see [SliceDisjoint]
+*/
+func (c *ComparableSliceAssertions[T]) Disjoint(other []T) {
+	c.t.Helper()
+	SliceDisjoint(c.t, c.actual, other)
+}
+
+/*
+Returns type-safe assertions on the given slice of ordered elements:
+
+	goassert.ThatOrderedSlice(a, timestamps).StrictlyIncreasing()
+*/
+func ThatOrderedSlice[T Ordered](a *Assertions, actual []T) *OrderedSliceAssertions[T] {
+	return &OrderedSliceAssertions[T]{ComparableSliceAssertions: ThatComparableSlice(a, actual)}
+}
+
+/*
+OrderedSliceAssertions are type-safe assertions on a slice of ordered elements, created by [ThatOrderedSlice]
+*/
+type OrderedSliceAssertions[T Ordered] struct {
+	*ComparableSliceAssertions[T]
+}
+
+/*
+Asserts that the elements of the slice are in non-decreasing order, see [SliceSorted]
+*/
+func (o *OrderedSliceAssertions[T]) Sorted() {
+	o.t.Helper()
+	SliceSorted(o.t, o.actual)
+}
+
+/*
+Asserts that every element of the slice is strictly less than the element following it, see [SliceStrictlyIncreasing]
+*/
+func (o *OrderedSliceAssertions[T]) StrictlyIncreasing() {
+	o.t.Helper()
+	SliceStrictlyIncreasing(o.t, o.actual)
+}
+
+/*
+Asserts that the elements of the slice are in non-increasing order, see [SliceDecreasing]
+*/
+func (o *OrderedSliceAssertions[T]) Decreasing() {
+	o.t.Helper()
+	SliceDecreasing(o.t, o.actual)
+}
+
+/*
+Returns type-safe assertions on the given slice of numbers:
+
+	goassert.ThatNumberSlice(a, weights).InDelta([]float64{0.2, 0.8}, 1e-9)
+*/
+func ThatNumberSlice[N Number](a *Assertions, actual []N) *NumberSliceAssertions[N] {
+	return &NumberSliceAssertions[N]{OrderedSliceAssertions: ThatOrderedSlice(a, actual)}
+}
+
+/*
+NumberSliceAssertions are type-safe assertions on a slice of numbers, created by [ThatNumberSlice]
+*/
+type NumberSliceAssertions[N Number] struct {
+	*OrderedSliceAssertions[N]
+}
+
+/*
+Asserts that every element of the slice is within the given delta of the expected element at the same index, see [SliceInDelta]
+*/
+func (n *NumberSliceAssertions[N]) InDelta(expected []N, delta float64) {
+	n.t.Helper()
+	SliceInDelta(n.t, expected, n.actual, delta)
+}
+
+/*
+Returns type-safe assertions on the keys extracted from the elements of the given slice with the given function:
+
+	goassert.ThatSliceBy(a, users, func(u User) string { return u.Name }).Sorted()
+*/
+func ThatSliceBy[T any, K Ordered](a *Assertions, actual []T, key func(T) K) *SliceByAssertions[T, K] {
+	return &SliceByAssertions[T, K]{t: a.t, actual: actual, key: key}
+}
+
+/*
+SliceByAssertions are type-safe assertions on the keys of the elements of a slice, created by [ThatSliceBy]
+*/
+type SliceByAssertions[T any, K Ordered] struct {
+	t      testing.TB
+	actual []T
+	key    func(T) K
+}
+
+/*
+Asserts that the keys of the elements of the slice are in non-decreasing order, see [SliceSortedBy]
+*/
+func (s *SliceByAssertions[T, K]) Sorted() {
+	s.t.Helper()
+	SliceSortedBy(s.t, s.actual, s.key)
+}
+
+/*
+Returns type-safe assertions on the given map of values of any type.
+Assertions comparing values with == are reached through [ThatComparableMap]:
+
+	goassert.ThatMap(a, response.Header).ContainsKey("Content-Type")
+*/
+func ThatMap[K comparable, V any](a *Assertions, actual map[K]V) *MapAssertions[K, V] {
+	return &MapAssertions[K, V]{t: a.t, actual: actual}
+}
+
+/*
+MapAssertions are type-safe assertions on a map, created by [ThatMap]
+*/
+type MapAssertions[K comparable, V any] struct {
+	t      testing.TB
+	actual map[K]V
+}
+
+/*
+Asserts that the map is deeply equal to the expected map, see [DeepEqual]
+*/
+func (m *MapAssertions[K, V]) Equal(expected map[K]V) {
+	m.t.Helper()
+	DeepEqual(m.t, expected, m.actual)
+}
+
+/*
+Asserts that the map is empty, see [EmptyMap]
+*/
+func (m *MapAssertions[K, V]) Empty() {
+	m.t.Helper()
+	EmptyMap(m.t, m.actual)
+}
+
+/*
+Asserts that the map is not nil or empty, see [NotEmptyMap]
+*/
+func (m *MapAssertions[K, V]) NotEmpty() {
+	m.t.Helper()
+	NotEmptyMap(m.t, m.actual)
+}
+
+/*
+Asserts that the map has the given length, see [MapLength]
+*/
+func (m *MapAssertions[K, V]) Length(expectedLength int) {
+	m.t.Helper()
+	MapLength(m.t, m.actual, expectedLength)
+}
+
+/*
+Asserts that the map contains the given key, see [MapContainsKey]
+*/
+func (m *MapAssertions[K, V]) ContainsKey(k K) {
+	m.t.Helper()
+	MapContainsKey(m.t, m.actual, k)
+}
+
+/*
+Asserts that the map does not contain the given key, see [MapNotContainsKey]
+*/
+func (m *MapAssertions[K, V]) NotContainsKey(k K) {
+	m.t.Helper()
+	MapNotContainsKey(m.t, m.actual, k)
+}
+
+/*
+Asserts that the map contains the given key with a value satisfying the given matcher, see [MapContainsMatch]
+*/
+func (m *MapAssertions[K, V]) ContainsMatch(k K, matcher Matcher[V]) {
+	m.t.Helper()
+	MapContainsMatch(m.t, m.actual, k, matcher)
+}
+
+/*
+Asserts that the map has the same keys as the expected map, see [MapKeysEqual]
+*/
+func (m *MapAssertions[K, V]) KeysEqual(expected map[K]V) {
+	m.t.Helper()
+	MapKeysEqual(m.t, expected, m.actual)
+}
+
+/*
+Returns type-safe assertions on the given map of comparable values:
+
+	goassert.ThatComparableMap(a, labels).Contains("env", "prod")
+*/
+func ThatComparableMap[K, V comparable](a *Assertions, actual map[K]V) *ComparableMapAssertions[K, V] {
+	return &ComparableMapAssertions[K, V]{MapAssertions: ThatMap(a, actual)}
+}
+
+/*
+ComparableMapAssertions are type-safe assertions on a map of comparable values, created by [ThatComparableMap]
+*/
+type ComparableMapAssertions[K, V comparable] struct {
+	*MapAssertions[K, V]
+}
+
+/*
+Asserts that the map contains the given key-value pair, see [MapContains]
+*/
+func (c *ComparableMapAssertions[K, V]) Contains(k K, v V) {
+	c.t.Helper()
+	MapContains(c.t, c.actual, k, v)
+}
+
+/*
+Asserts that the map does not contain the given key-value pair, see [MapNotContains]
+*/
+func (c *ComparableMapAssertions[K, V]) NotContains(k K, v V) {
+	c.t.Helper()
+	MapNotContains(c.t, c.actual, k, v)
+}
+
+/*
+Asserts that every key-value pair of the expected map can be found in the map, see [MapSubset]
+*/
+func (c *ComparableMapAssertions[K, V]) Subset(expected map[K]V) {
+	c.t.Helper()
+	MapSubset(c.t, expected, c.actual)
+}
+
+/*
+Returns type-safe assertions on the given map of numbers:
+
+	goassert.ThatNumberMap(a, totals).InDelta(map[string]float64{"eu": 12.5}, 0.01)
+*/
+func ThatNumberMap[K comparable, N Number](a *Assertions, actual map[K]N) *NumberMapAssertions[K, N] {
+	return &NumberMapAssertions[K, N]{ComparableMapAssertions: ThatComparableMap(a, actual)}
+}
+
+/*
+NumberMapAssertions are type-safe assertions on a map of numbers, created by [ThatNumberMap]
+*/
+type NumberMapAssertions[K comparable, N Number] struct {
+	*ComparableMapAssertions[K, N]
+}
+
+/*
+Asserts that the map has the same keys as the expected map and that every value is within the given delta
+of the expected value for the same key, see [MapInDelta]
+*/
+func (n *NumberMapAssertions[K, N]) InDelta(expected map[K]N, delta float64) {
+	n.t.Helper()
+	MapInDelta(n.t, expected, n.actual, delta)
+}
+
+/*
+Returns type-safe assertions on the given channel:
+
+	goassert.ThatChan(a, results).ReceivesValue("done", time.Second)
+*/
+func ThatChan[T comparable](a *Assertions, actual <-chan T) *ChanAssertions[T] {
+	return &ChanAssertions[T]{t: a.t, actual: actual}
+}
+
+/*
+ChanAssertions are type-safe assertions on a channel, created by [ThatChan]
+*/
+type ChanAssertions[T comparable] struct {
+	t      testing.TB
+	actual <-chan T
+}
+
+/*
+Asserts that a value is received from the channel within the given timeout and returns it, see [ChanReceives]
+*/
+func (c *ChanAssertions[T]) Receives(timeout time.Duration) T {
+	c.t.Helper()
+	return ChanReceives(c.t, c.actual, timeout)
+}
+
+/*
+Asserts that the expected value is the next value received from the channel within the given timeout, see [ChanReceivesValue]
+*/
+func (c *ChanAssertions[T]) ReceivesValue(expected T, timeout time.Duration) {
+	c.t.Helper()
+	ChanReceivesValue(c.t, c.actual, expected, timeout)
+}
+
+/*
+Asserts that the channel is closed, see [ChanClosed]
+*/
+func (c *ChanAssertions[T]) Closed() {
+	c.t.Helper()
+	ChanClosed(c.t, c.actual)
+}
+
+/*
+Asserts that the channel is not closed, see [ChanNotClosed]
+*/
+func (c *ChanAssertions[T]) NotClosed() {
+	c.t.Helper()
+	ChanNotClosed(c.t, c.actual)
+}
+
+/*
+Asserts that the channel holds no buffered values, see [ChanEmpty]
+*/
+func (c *ChanAssertions[T]) Empty() {
+	c.t.Helper()
+	ChanEmpty(c.t, c.actual)
+}
+
+/*
+Asserts that the channel holds the expected number of buffered values, see [ChanLength]
+*/
+func (c *ChanAssertions[T]) Length(expectedLength int) {
+	c.t.Helper()
+	ChanLength(c.t, c.actual, expectedLength)
+}
+
+/*
+Asserts that no value is received from the channel during the given duration, see [ChanNeverReceives]
+*/
+func (c *ChanAssertions[T]) NeverReceives(duration time.Duration) {
+	c.t.Helper()
+	ChanNeverReceives(c.t, c.actual, duration)
+}
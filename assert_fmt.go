@@ -1,14 +1,156 @@
 package goassert
 
-import "fmt"
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
 
 var inqualityMsgTemplate string = "Expected: %v. Actual: %v"
 var equalityMsgTemplate string = "Expected to not equal: %v"
+var inequalityDiffMsgTemplate string = "Expected and actual are not equal\n%s%s"
+var equalityMultilineMsgTemplate string = "Expected to not equal:\n%s"
 
+/*
+Builds the failure message for two values that were expected to be equal.
+Values that fit on a single line are printed inline.
+Multiline strings are rendered as a line diff with visible whitespace.
+Other values are pretty-printed and rendered as a unified diff, preceded by the paths of the differing fields
+*/
 func inequalityMsg[T any](expected T, actual T) string {
-	return fmt.Sprintf(inqualityMsgTemplate, expected, actual)
+	expectedValue := reflect.ValueOf(&expected).Elem()
+	actualValue := reflect.ValueOf(&actual).Elem()
+
+	expectedString, expectedIsString := stringValue(expectedValue)
+	actualString, actualIsString := stringValue(actualValue)
+	if expectedIsString && actualIsString && (isMultilineText(expectedString) || isMultilineText(actualString)) {
+		return textInequalityMsg(expectedString, actualString)
+	}
+
+	expectedText := prettyPrintValue(expectedValue, false)
+	actualText := prettyPrintValue(actualValue, false)
+	if expectedText == actualText {
+		return indistinguishableMsg(expectedValue, actualValue, expectedText)
+	}
+	if !isMultiline(expectedText) && !isMultiline(actualText) {
+		return fmt.Sprintf(inqualityMsgTemplate, expected, actual)
+	}
+
+	paths := ""
+	if differingPaths := diffPaths(expectedValue, actualValue); !isRootOnly(differingPaths) {
+		paths = "Differences:\n" + formatDiffPaths(differingPaths)
+	}
+
+	return fmt.Sprintf(inequalityDiffMsgTemplate, paths, unifiedDiff(expectedText, actualText))
+}
+
+/*
+Builds the failure message for two unequal values that are printed the same, e.g. pointers to equal values,
+NaNs or non-nil funcs. A diff would be empty, so the differing paths and the identities of the values are shown instead
+*/
+func indistinguishableMsg(expected reflect.Value, actual reflect.Value, text string) string {
+	var b strings.Builder
+	b.WriteString("Expected and actual are not equal but are printed the same\n")
+	if differingPaths := diffPaths(expected, actual); !isRootOnly(differingPaths) {
+		b.WriteString("Differences:\n")
+		b.WriteString(formatDiffPaths(differingPaths))
+	}
+	fmt.Fprintf(&b, "expected: %s\nactual:   %s\nvalue: %s", describeIdentity(expected), describeIdentity(actual), text)
+
+	return b.String()
+}
+
+/*
+Describes the dynamic type of the given value and, for reference types, the address it refers to
+*/
+func describeIdentity(value reflect.Value) string {
+	if value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	if !value.IsValid() || value.Kind() == reflect.Interface {
+		return "nil"
+	}
+
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return fmt.Sprintf("%v at %#x", value.Type(), value.Pointer())
+	}
+
+	return value.Type().String()
 }
 
 func equalityMsg[T any](expected T) string {
+	expectedText := prettyPrintValue(reflect.ValueOf(&expected).Elem(), false)
+	if isMultiline(expectedText) {
+		return fmt.Sprintf(equalityMultilineMsgTemplate, expectedText)
+	}
+
 	return fmt.Sprintf(equalityMsgTemplate, expected)
 }
+
+/*
+Builds a description of the given error and every error it wraps.
+Errors wrapping several errors, such as the ones created by errors.Join, are printed as an indented tree
+*/
+func errorChainMsg(err error) string {
+	var b strings.Builder
+	b.WriteString("Error chain:")
+	writeErrorChain(&b, err, 1)
+
+	return b.String()
+}
+
+func writeErrorChain(b *strings.Builder, err error, depth int) {
+	for err != nil {
+		fmt.Fprintf(b, "\n%s%T: %q", strings.Repeat("\t", depth), err, err.Error())
+
+		switch wrapper := err.(type) {
+		case interface{ Unwrap() []error }:
+			for _, wrapped := range wrapper.Unwrap() {
+				writeErrorChain(b, wrapped, depth+1)
+			}
+			return
+		case interface{ Unwrap() error }:
+			err = wrapper.Unwrap()
+			depth++
+		default:
+			return
+		}
+	}
+}
+
+func similarSliceMsg[T any](missing []elementCount[T], extra []elementCount[T]) string {
+	var b strings.Builder
+	b.WriteString("Expected slices to have the same elements in any order but they did not")
+	if len(missing) > 0 {
+		b.WriteString("\n\tmissing from actual: ")
+		writeElementCounts(&b, missing)
+	}
+	if len(extra) > 0 {
+		b.WriteString("\n\textra in actual: ")
+		writeElementCounts(&b, extra)
+	}
+
+	return b.String()
+}
+
+func writeElementCounts[T any](b *strings.Builder, counts []elementCount[T]) {
+	for i, element := range counts {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+
+		fmt.Fprintf(b, "%v", element.value)
+		if element.count > 1 {
+			fmt.Fprintf(b, " (x%d)", element.count)
+		}
+	}
+}
+
+func isMultiline(text string) bool {
+	return strings.Contains(text, "\n")
+}
+
+func isRootOnly(paths []string) bool {
+	return len(paths) == 0 || len(paths) == 1 && paths[0] == ""
+}
@@ -0,0 +1,142 @@
+package goassert
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type mockAddress struct {
+	City string
+	Zip  string
+}
+
+type mockUser struct {
+	Name    string
+	Address *mockAddress
+}
+
+type mockDirectory struct {
+	Users []mockUser
+}
+
+func newMockDirectory(zip string) mockDirectory {
+	return mockDirectory{
+		Users: []mockUser{
+			{Name: "Ann", Address: &mockAddress{City: "Oslo", Zip: "0150"}},
+			{Name: "Bob", Address: &mockAddress{City: "Bergen", Zip: zip}},
+		},
+	}
+}
+
+func Test_InequalityMsgShouldPrintInline_GivenSingleLineValues(t *testing.T) {
+	msg := inequalityMsg(2, 1)
+
+	if msg != "Expected: 2. Actual: 1" {
+		t.Errorf("inequalityMsg did not print single line values inline but got %q", msg)
+	}
+}
+
+func Test_InequalityMsgShouldIncludeFieldPath_GivenNestedStructs(t *testing.T) {
+	msg := inequalityMsg(newMockDirectory("5003"), newMockDirectory("5004"))
+
+	if !strings.Contains(msg, ".Users[1].Address.Zip") {
+		t.Errorf("inequalityMsg did not include the path of the differing field but got:\n%s", msg)
+	}
+}
+
+func Test_InequalityMsgShouldRenderUnifiedDiff_GivenNestedStructs(t *testing.T) {
+	msg := inequalityMsg(newMockDirectory("5003"), newMockDirectory("5004"))
+
+	for _, expectedLine := range []string{"--- expected", "+++ actual", "-\t\t\t\tZip: \"5003\",", "+\t\t\t\tZip: \"5004\","} {
+		if !strings.Contains(msg, expectedLine) {
+			t.Errorf("inequalityMsg did not contain line %q but got:\n%s", expectedLine, msg)
+		}
+	}
+}
+
+func Test_InequalityMsgShouldIncludeMapKeyPaths_GivenMapsWithDifferentKeys(t *testing.T) {
+	msg := inequalityMsg(map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1, "c": 2})
+
+	if !strings.Contains(msg, `["b"]`) || !strings.Contains(msg, `["c"]`) {
+		t.Errorf("inequalityMsg did not include missing and unexpected map keys but got:\n%s", msg)
+	}
+}
+
+func Test_InequalityMsgShouldIncludeIndexPath_GivenSlicesOfDifferentLengths(t *testing.T) {
+	msg := inequalityMsg([]int{1, 2}, []int{1, 2, 3})
+
+	if !strings.Contains(msg, "[2]") || !strings.Contains(msg, "+\t3,") {
+		t.Errorf("inequalityMsg did not report the extra element but got:\n%s", msg)
+	}
+}
+
+func Test_EqualShouldReportAddresses_GivenPointersToEqualValues(t *testing.T) {
+	tester := newRecordingT()
+	expected, actual := &mockAddress{City: "Oslo"}, &mockAddress{City: "Oslo"}
+
+	Equal(tester, expected, actual)
+
+	for _, want := range []string{
+		"Expected and actual are not equal but are printed the same",
+		fmt.Sprintf("expected: *goassert.mockAddress at %p", expected),
+		fmt.Sprintf("actual:   *goassert.mockAddress at %p", actual),
+	} {
+		if !strings.Contains(tester.output(), want) {
+			t.Errorf("Equal did not report %q but got:\n%s", want, tester.output())
+		}
+	}
+}
+
+func Test_InequalityMsgShouldReportDifferingPaths_GivenValuesPrintedTheSame(t *testing.T) {
+	type handler struct {
+		Name string
+		Run  func()
+	}
+
+	msg := inequalityMsg(handler{Name: "a", Run: func() {}}, handler{Name: "a", Run: func() {}})
+
+	if !strings.Contains(msg, "Differences:\n\t.Run\n") {
+		t.Errorf("inequalityMsg did not report the differing func field but got:\n%s", msg)
+	}
+}
+
+func Test_EqualityMsgShouldPrettyPrint_GivenCompositeValue(t *testing.T) {
+	msg := equalityMsg(newMockStruct(10))
+
+	if msg != "Expected to not equal:\n&goassert.mockStruct{\n\tProp: 10,\n}" {
+		t.Errorf("equalityMsg did not pretty print the composite value but got %q", msg)
+	}
+}
+
+func Test_PrettyPrintShouldSortMapKeys(t *testing.T) {
+	text := prettyPrint(map[int]string{10: "ten", 2: "two", 5: "five"})
+
+	if text != "map[int]string{\n\t2: \"two\",\n\t5: \"five\",\n\t10: \"ten\",\n}" {
+		t.Errorf("prettyPrint did not sort the map keys but got %q", text)
+	}
+}
+
+func Test_PrettyPrintShouldNotRecurseForever_GivenCyclicPointers(t *testing.T) {
+	type node struct {
+		Next *node
+	}
+	cyclic := &node{}
+	cyclic.Next = cyclic
+
+	text := prettyPrint(cyclic)
+
+	if !strings.Contains(text, "<cycle") {
+		t.Errorf("prettyPrint did not mark the cycle but got %q", text)
+	}
+}
+
+func Test_DeepEqualShouldReportDiff_WhenActualDoesNotMatchExpected_GivenStructs(t *testing.T) {
+	tester := newRecordingT()
+
+	DeepEqual(tester, newMockDirectory("5003"), newMockDirectory("5004"))
+
+	if !strings.Contains(tester.output(), ".Users[1].Address.Zip") {
+		t.Errorf("DeepEqual did not report the path of the differing field but got:\n%s", tester.output())
+	}
+}
@@ -0,0 +1,115 @@
+package goassert
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+const updateGoldenFilesEnv = "GOASSERT_UPDATE"
+
+var updateGoldenFiles = flag.Bool("goassert.update", false,
+	"rewrite golden files and snapshots with the actual values instead of comparing against them")
+
+/*
+Asserts that the content of the golden file at the given path equals the given bytes.
+When the test binary is run with -goassert.update or the GOASSERT_UPDATE environment variable is set to true,
+the golden file is written with the given bytes instead, creating missing directories.
+Text content is reported as a line diff on failure
+*/
+func EqualGoldenFile(t testing.TB, path string, actual []byte) {
+	t.Helper()
+
+	if shouldUpdateGoldenFiles() {
+		if err := writeGoldenFile(path, actual); err != nil {
+			failf(t, "Could not update golden file %s: %v", path, err)
+		}
+		return
+	}
+
+	expected, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		failf(t, "Golden file %s does not exist. Run the tests with -goassert.update to create it", path)
+		return
+	}
+	if err != nil {
+		failf(t, "Could not read golden file %s: %v", path, err)
+		return
+	}
+
+	if !bytes.Equal(expected, actual) {
+		failf(t, "Golden file %s does not match the actual content\n%s", path, goldenMismatchMsg(expected, actual))
+	}
+}
+
+/*
+Asserts that the given value matches the snapshot of the current test, stored in testdata/snapshots and named after t.Name().
+Strings and byte slices are stored as they are, other values are stored pretty-printed.
+Snapshots are created and updated the same way as golden files, see [EqualGoldenFile].
+Each test has a single snapshot, so use subtests to take several snapshots in one test
+*/
+func MatchSnapshot(t testing.TB, value interface{}) {
+	t.Helper()
+
+	EqualGoldenFile(t, snapshotPath(t.Name()), snapshotContent(value))
+}
+
+func shouldUpdateGoldenFiles() bool {
+	if *updateGoldenFiles {
+		return true
+	}
+
+	update, _ := strconv.ParseBool(os.Getenv(updateGoldenFilesEnv))
+	return update
+}
+
+func writeGoldenFile(path string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0o644)
+}
+
+func snapshotPath(testName string) string {
+	fileName := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' {
+			return r
+		}
+		return '_'
+	}, testName)
+
+	return filepath.Join("testdata", "snapshots", fileName+".snap")
+}
+
+func snapshotContent(value interface{}) []byte {
+	switch v := value.(type) {
+	case []byte:
+		return v
+	case string:
+		return []byte(v)
+	}
+
+	return []byte(prettyPrint(value) + "\n")
+}
+
+func goldenMismatchMsg(expected []byte, actual []byte) string {
+	if utf8.Valid(expected) && utf8.Valid(actual) {
+		return unifiedDiff(string(expected), string(actual))
+	}
+
+	offset := 0
+	for offset < len(expected) && offset < len(actual) && expected[offset] == actual[offset] {
+		offset++
+	}
+
+	return fmt.Sprintf("Expected %d bytes but got %d bytes, first difference at byte offset %d", len(expected), len(actual), offset)
+}
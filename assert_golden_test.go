@@ -0,0 +1,121 @@
+package goassert
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Keeps tests comparing against committed snapshots from overwriting them when the suite runs in update mode
+func disableGoldenFileUpdates(t *testing.T) {
+	t.Setenv(updateGoldenFilesEnv, "false")
+
+	update := *updateGoldenFiles
+	*updateGoldenFiles = false
+	t.Cleanup(func() {
+		*updateGoldenFiles = update
+	})
+}
+
+func Test_EqualGoldenFileShouldPass_WhenContentMatchesGoldenFile(t *testing.T) {
+	tester := new(testing.T)
+
+	path := filepath.Join(t.TempDir(), "output.golden")
+	if err := os.WriteFile(path, []byte("line 1\nline 2\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	EqualGoldenFile(tester, path, []byte("line 1\nline 2\n"))
+
+	if tester.Failed() {
+		t.Error("EqualGoldenFile did not pass when the content matched the golden file")
+	}
+}
+
+func Test_EqualGoldenFileShouldReportDiff_WhenContentDoesNotMatchGoldenFile(t *testing.T) {
+	disableGoldenFileUpdates(t)
+	tester := newRecordingT()
+
+	path := filepath.Join(t.TempDir(), "output.golden")
+	if err := os.WriteFile(path, []byte("line 1\nline 2\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	EqualGoldenFile(tester, path, []byte("line 1\nline two\n"))
+
+	if !tester.Failed() || !strings.Contains(tester.output(), "-line 2\n+line two") {
+		t.Errorf("EqualGoldenFile did not report the diff against the golden file but got %q", tester.output())
+	}
+}
+
+func Test_EqualGoldenFileShouldReportByteOffset_WhenBinaryContentDoesNotMatchGoldenFile(t *testing.T) {
+	disableGoldenFileUpdates(t)
+	tester := newRecordingT()
+
+	path := filepath.Join(t.TempDir(), "output.golden")
+	if err := os.WriteFile(path, []byte{0xff, 0x00, 0x01}, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	EqualGoldenFile(tester, path, []byte{0xff, 0x00, 0x02})
+
+	if !tester.Failed() || !strings.Contains(tester.output(), "first difference at byte offset 2") {
+		t.Errorf("EqualGoldenFile did not report the offset of the first difference but got %q", tester.output())
+	}
+}
+
+func Test_EqualGoldenFileShouldFail_WhenGoldenFileDoesNotExist(t *testing.T) {
+	disableGoldenFileUpdates(t)
+	tester := new(testing.T)
+
+	EqualGoldenFile(tester, filepath.Join(t.TempDir(), "missing.golden"), []byte("content"))
+
+	if !tester.Failed() {
+		t.Error("EqualGoldenFile did not fail when the golden file did not exist")
+	}
+}
+
+func Test_EqualGoldenFileShouldWriteGoldenFile_WhenUpdateIsEnabled(t *testing.T) {
+	t.Setenv(updateGoldenFilesEnv, "true")
+	tester := new(testing.T)
+
+	path := filepath.Join(t.TempDir(), "nested", "output.golden")
+	EqualGoldenFile(tester, path, []byte("updated"))
+
+	content, err := os.ReadFile(path)
+	if tester.Failed() || err != nil || string(content) != "updated" {
+		t.Errorf("EqualGoldenFile did not write the golden file when update was enabled, content: %q, error: %v", content, err)
+	}
+}
+
+func Test_MatchSnapshotShouldPass_WhenValueMatchesSnapshot(t *testing.T) {
+	tester := newRecordingT()
+	tester.name = t.Name()
+
+	MatchSnapshot(tester, newMockDirectory("5003"))
+
+	if tester.Failed() {
+		t.Errorf("MatchSnapshot did not pass when the value matched the snapshot but got:\n%s", tester.output())
+	}
+}
+
+func Test_MatchSnapshotShouldReportDiff_WhenValueDoesNotMatchSnapshot(t *testing.T) {
+	disableGoldenFileUpdates(t)
+	tester := newRecordingT()
+	tester.name = "Test_MatchSnapshotShouldPass_WhenValueMatchesSnapshot"
+
+	MatchSnapshot(tester, newMockDirectory("5004"))
+
+	if !tester.Failed() || !strings.Contains(tester.output(), "+\t\t\t\tZip: \"5004\",") {
+		t.Errorf("MatchSnapshot did not report the diff against the snapshot but got:\n%s", tester.output())
+	}
+}
+
+func Test_SnapshotPathShouldBeNamedAfterTest_GivenSubtestName(t *testing.T) {
+	path := snapshotPath("Test_Users/admin user")
+
+	if path != filepath.Join("testdata", "snapshots", "Test_Users_admin_user.snap") {
+		t.Errorf("snapshotPath did not name the snapshot after the test but got %q", path)
+	}
+}
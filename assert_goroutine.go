@@ -0,0 +1,166 @@
+package goassert
+
+import (
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	defaultGoroutineLeakGracePeriod = time.Second
+	goroutineLeakCheckInterval      = 10 * time.Millisecond
+)
+
+/*
+Snapshots the running goroutines and registers a cleanup asserting that no goroutine started since then is still running
+once the test and its subtests have finished. The cleanup retries for a grace period, one second by default,
+to give goroutines time to finish. Call it at the start of the test, not deferred, since the snapshot is taken right away:
+
+	goassert.NoGoroutineLeaks(t)
+
+The goroutines of other tests running in parallel are reported as leaks unless they are ignored with [IgnoreGoroutines]
+*/
+func NoGoroutineLeaks(t testing.TB, options ...GoroutineLeakOption) {
+	t.Helper()
+
+	config := goroutineLeakConfig{gracePeriod: defaultGoroutineLeakGracePeriod}
+	for _, option := range options {
+		option(&config)
+	}
+
+	initialGoroutines := make(map[uint64]bool)
+	for _, g := range runningGoroutines() {
+		initialGoroutines[g.id] = true
+	}
+
+	t.Cleanup(func() {
+		t.Helper()
+
+		var leaks []goroutine
+		pollUntil(func() bool {
+			leaks = leakedGoroutines(initialGoroutines, config.ignored)
+			return len(leaks) == 0
+		}, true, config.gracePeriod, goroutineLeakCheckInterval)
+
+		if len(leaks) > 0 {
+			fail(t, goroutineLeaksMsg(leaks))
+		}
+	})
+}
+
+/*
+GoroutineLeakOption configures the goroutine leak detection of [NoGoroutineLeaks]
+*/
+type GoroutineLeakOption func(*goroutineLeakConfig)
+
+/*
+Ignores the goroutines whose stack trace contains any of the given substrings, e.g. a function name
+such as "database/sql.(*DB).connectionOpener" for background goroutines that are known to outlive the test
+*/
+func IgnoreGoroutines(substrings ...string) GoroutineLeakOption {
+	return func(config *goroutineLeakConfig) {
+		config.ignored = append(config.ignored, substrings...)
+	}
+}
+
+/*
+Sets how long leaked goroutines are given to finish before they are reported
+*/
+func GoroutineLeakGracePeriod(gracePeriod time.Duration) GoroutineLeakOption {
+	return func(config *goroutineLeakConfig) {
+		config.gracePeriod = gracePeriod
+	}
+}
+
+type goroutineLeakConfig struct {
+	gracePeriod time.Duration
+	ignored     []string
+}
+
+type goroutine struct {
+	id    uint64
+	stack string
+}
+
+func leakedGoroutines(initialGoroutines map[uint64]bool, ignored []string) []goroutine {
+	currentID := currentGoroutineID()
+
+	var leaks []goroutine
+	for _, g := range runningGoroutines() {
+		if initialGoroutines[g.id] || g.id == currentID || containsAny(g.stack, ignored) {
+			continue
+		}
+		leaks = append(leaks, g)
+	}
+
+	return leaks
+}
+
+func runningGoroutines() []goroutine {
+	var goroutines []goroutine
+	for _, stack := range strings.Split(stackTraces(true), "\n\n") {
+		if id, ok := parseGoroutineID(stack); ok {
+			goroutines = append(goroutines, goroutine{id: id, stack: stack})
+		}
+	}
+
+	return goroutines
+}
+
+func currentGoroutineID() uint64 {
+	id, _ := parseGoroutineID(stackTraces(false))
+	return id
+}
+
+func stackTraces(all bool) string {
+	buf := make([]byte, 1<<16)
+	for {
+		n := runtime.Stack(buf, all)
+		if n < len(buf) {
+			return string(buf[:n])
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
+
+/*
+Parses the id from the header of a goroutine stack trace, e.g. "goroutine 18 [chan receive]:"
+*/
+func parseGoroutineID(stack string) (uint64, bool) {
+	header := strings.TrimPrefix(stack, "goroutine ")
+	if len(header) == len(stack) {
+		return 0, false
+	}
+
+	end := strings.IndexByte(header, ' ')
+	if end < 0 {
+		return 0, false
+	}
+
+	id, err := strconv.ParseUint(header[:end], 10, 64)
+	return id, err == nil
+}
+
+func containsAny(s string, substrings []string) bool {
+	for _, substring := range substrings {
+		if strings.Contains(s, substring) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func goroutineLeaksMsg(leaks []goroutine) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Expected no leaked goroutines but found %d:", len(leaks))
+	for _, leak := range leaks {
+		b.WriteString("\n\n")
+		b.WriteString(strings.TrimSpace(leak.stack))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,62 @@
+package goassert
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func blockUntilClosed(release chan struct{}) {
+	<-release
+}
+
+func Test_NoGoroutineLeaksShouldPass_WhenNoGoroutineIsStarted(t *testing.T) {
+	tester := newRecordingT()
+
+	NoGoroutineLeaks(tester, GoroutineLeakGracePeriod(10*time.Millisecond))
+	tester.runCleanups()
+
+	if tester.Failed() {
+		t.Error("NoGoroutineLeaks did not pass when no goroutine was started")
+	}
+}
+
+func Test_NoGoroutineLeaksShouldPass_WhenStartedGoroutineFinishesWithinGracePeriod(t *testing.T) {
+	tester := newRecordingT()
+
+	NoGoroutineLeaks(tester)
+	go time.Sleep(20 * time.Millisecond)
+	tester.runCleanups()
+
+	if tester.Failed() {
+		t.Error("NoGoroutineLeaks did not pass when the started goroutine finished within the grace period")
+	}
+}
+
+func Test_NoGoroutineLeaksShouldReportStack_WhenStartedGoroutineIsStillRunning(t *testing.T) {
+	tester := newRecordingT()
+	release := make(chan struct{})
+	defer close(release)
+
+	NoGoroutineLeaks(tester, GoroutineLeakGracePeriod(20*time.Millisecond))
+	go blockUntilClosed(release)
+	tester.runCleanups()
+
+	if !tester.Failed() || !strings.Contains(tester.output(), "goassert.blockUntilClosed") {
+		t.Errorf("NoGoroutineLeaks did not report the stack of the leaked goroutine but got %q", tester.output())
+	}
+}
+
+func Test_NoGoroutineLeaksShouldPass_WhenLeakedGoroutineIsIgnored(t *testing.T) {
+	tester := newRecordingT()
+	release := make(chan struct{})
+	defer close(release)
+
+	NoGoroutineLeaks(tester, GoroutineLeakGracePeriod(20*time.Millisecond), IgnoreGoroutines("goassert.blockUntilClosed"))
+	go blockUntilClosed(release)
+	tester.runCleanups()
+
+	if tester.Failed() {
+		t.Error("NoGoroutineLeaks did not pass when the leaked goroutine was ignored")
+	}
+}
@@ -0,0 +1,57 @@
+package goassert
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+/*
+Runs the given assertions as a group: every assertion runs to completion and their failures are collected,
+then reported to the test as a single numbered block headed by the given name.
+The assertions are run against the given [Asserter], which any goassert assertion accepts as its testing.TB:
+
+	goassert.Group(t, "user response", func(a *goassert.Asserter) {
+		goassert.Equal(a, 200, response.StatusCode)
+		goassert.MapContainsKey(a, response.Header, "Content-Type")
+	})
+
+Calling FailNow, Fatal or Fatalf on the Asserter stops the group but still reports every failure collected so far.
+Use require.Group to stop the test once the group has failed
+*/
+func Group(t testing.TB, name string, assertions func(a *Asserter)) {
+	t.Helper()
+
+	a := &Asserter{failureCollector{TB: t}}
+	a.run(func() { assertions(a) })
+
+	if a.Failed() {
+		fail(t, a.groupMsg(name))
+	}
+}
+
+/*
+Asserter is the [testing.TB] handed to the function run by [Group].
+It collects the failures of the group instead of reporting them to the test one by one
+*/
+type Asserter struct {
+	failureCollector
+}
+
+func (a *Asserter) groupMsg(name string) string {
+	failures := a.collectedFailures()
+	if len(failures) == 0 {
+		return fmt.Sprintf("%s: failed without a message", name)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %d assertion failure", name, len(failures))
+	if len(failures) > 1 {
+		b.WriteByte('s')
+	}
+	for i, failure := range failures {
+		fmt.Fprintf(&b, "\n\t%d. %s", i+1, strings.ReplaceAll(failure, "\n", "\n\t   "))
+	}
+
+	return b.String()
+}
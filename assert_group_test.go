@@ -0,0 +1,103 @@
+package goassert
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_GroupShouldPass_WhenAllAssertionsPass(t *testing.T) {
+	tester := new(testing.T)
+
+	Group(tester, "numbers", func(a *Asserter) {
+		Equal(a, 1, 1)
+		Greater(a, 2, 1)
+	})
+
+	if tester.Failed() {
+		t.Error("Group did not pass when all assertions passed")
+	}
+}
+
+func Test_GroupShouldReportNumberedFailures_WhenSeveralAssertionsFail(t *testing.T) {
+	tester := newRecordingT()
+
+	Group(tester, "user response", func(a *Asserter) {
+		Equal(a, 200, 404)
+		Equal(a, "json", "json")
+		MapContainsKey(a, map[string]int{}, "id")
+	})
+
+	expected := "user response: 2 assertion failures\n" +
+		"\t1. Expected: 200. Actual: 404\n" +
+		"\t2. The given map was expected to contain key id but did not"
+	if len(tester.messages) != 1 || tester.output() != expected {
+		t.Errorf("Group did not report the failures as a single numbered block but got %q", tester.messages)
+	}
+}
+
+func Test_GroupShouldIndentMultilineFailures_WhenAssertionFailsWithMultilineMessage(t *testing.T) {
+	tester := newRecordingT()
+
+	Group(tester, "text", func(a *Asserter) {
+		a.Error("first line\nsecond line")
+	})
+
+	if tester.output() != "text: 1 assertion failure\n\t1. first line\n\t   second line" {
+		t.Errorf("Group did not indent the multiline failure but got %q", tester.output())
+	}
+}
+
+func Test_GroupShouldReportCollectedFailures_WhenAssertionsStopTheGroup(t *testing.T) {
+	tester := newRecordingT()
+	completed := false
+
+	Group(tester, "fatal", func(a *Asserter) {
+		Equal(a, 1, 2)
+		a.Fatal("stopped")
+		completed = true
+	})
+
+	if completed || tester.output() != "fatal: 2 assertion failures\n\t1. Expected: 1. Actual: 2\n\t2. stopped" {
+		t.Errorf("Group did not stop and report the collected failures but got %q", tester.output())
+	}
+}
+
+func Test_GroupShouldPrefixFailures_GivenAssertionsWrappedWithContext(t *testing.T) {
+	tester := newRecordingT()
+
+	Group(tester, "rows", func(a *Asserter) {
+		Equal(With(a, "row %d", 2), 1, 2)
+	})
+
+	if tester.output() != "rows: 1 assertion failure\n\t1. row 2: Expected: 1. Actual: 2" {
+		t.Errorf("Group did not collect the prefixed failure but got %q", tester.output())
+	}
+}
+
+func Test_GroupShouldFail_WhenAssertionsFailWithoutMessage(t *testing.T) {
+	tester := new(testing.T)
+
+	Group(tester, "silent", func(a *Asserter) {
+		a.Fail()
+	})
+
+	if !tester.Failed() {
+		t.Error("Group did not fail when the assertions failed without a message")
+	}
+}
+
+func Test_GroupShouldReportPanicWithStack_WhenAssertionsPanic(t *testing.T) {
+	tester := newRecordingT()
+
+	Group(tester, "panicking", func(a *Asserter) {
+		Equal(a, 1, 2)
+		var counts map[string]int
+		counts["a"]++
+	})
+
+	for _, expected := range []string{"panicking: 2 assertion failures", "1. Expected: 1. Actual: 2", "2. Unexpected panic: assignment to entry in nil map", "Panic stack:"} {
+		if !strings.Contains(tester.output(), expected) {
+			t.Errorf("Group did not report %q but got:\n%s", expected, tester.output())
+		}
+	}
+}
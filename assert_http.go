@@ -0,0 +1,283 @@
+package goassert
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+const maxDisplayedBodyLength = 1024
+
+/*
+Asserts that the given response has the expected status code.
+Like every HTTP assertion, failures include the request line, the response headers and the beginning of the body
+*/
+func StatusCode(t testing.TB, response *http.Response, expected int) {
+	t.Helper()
+
+	if !checkResponse(t, response) {
+		return
+	}
+
+	if response.StatusCode != expected {
+		fail(t, httpFailureMsg(fmt.Sprintf("Expected status code %d but got %d", expected, response.StatusCode), response))
+	}
+}
+
+/*
+Asserts that the first value of the given header of the given response equals the expected value
+*/
+func HeaderEqual(t testing.TB, response *http.Response, name string, expected string) {
+	t.Helper()
+
+	if !checkResponse(t, response) {
+		return
+	}
+
+	values := response.Header.Values(name)
+	if len(values) == 0 {
+		fail(t, httpFailureMsg(fmt.Sprintf("Expected header %s to be %q but it was not set", name, expected), response))
+		return
+	}
+
+	if values[0] != expected {
+		fail(t, httpFailureMsg(fmt.Sprintf("Expected header %s to be %q but got %q", name, expected, values[0]), response))
+	}
+}
+
+/*
+Asserts that a value of the given header of the given response contains the given substring
+*/
+func HeaderContains(t testing.TB, response *http.Response, name string, substring string) {
+	t.Helper()
+
+	if !checkResponse(t, response) {
+		return
+	}
+
+	for _, value := range response.Header.Values(name) {
+		if strings.Contains(value, substring) {
+			return
+		}
+	}
+
+	fail(t, httpFailureMsg(fmt.Sprintf("Expected header %s to contain %q but it did not", name, substring), response))
+}
+
+/*
+Asserts that the body of the given response equals the expected body.
+The body is read and replaced by an in-memory copy, so several body assertions can be run on the same response
+*/
+func BodyEqual(t testing.TB, response *http.Response, expected string) {
+	t.Helper()
+
+	body, ok := readResponseBody(t, response)
+	if !ok {
+		return
+	}
+
+	if body != expected {
+		fail(t, httpFailureMsg(inequalityMsg(expected, body), response))
+	}
+}
+
+/*
+Asserts that the body of the given response is a JSON document semantically equal to the expected one, see [JSONEq]
+*/
+func BodyJSONEq(t testing.TB, response *http.Response, expected string) {
+	t.Helper()
+
+	body, ok := readResponseBody(t, response)
+	if !ok {
+		return
+	}
+
+	expectedValue, err := decodeJSON(expected)
+	if err != nil {
+		failf(t, "Expected value is not valid JSON: %v", err)
+		return
+	}
+
+	actualValue, err := decodeJSON(body)
+	if err != nil {
+		fail(t, httpFailureMsg(fmt.Sprintf("Body is not valid JSON: %v", err), response))
+		return
+	}
+
+	differences := jsonDifferences(expectedValue, actualValue, false)
+	if len(differences) > 0 {
+		fail(t, httpFailureMsg(jsonDifferencesMsg("Expected JSON body to equal the expected document but it differs at:", differences), response))
+	}
+}
+
+/*
+Asserts that the body of the given response contains the given substring
+*/
+func BodyContains(t testing.TB, response *http.Response, substring string) {
+	t.Helper()
+
+	body, ok := readResponseBody(t, response)
+	if !ok {
+		return
+	}
+
+	if !strings.Contains(body, substring) {
+		fail(t, httpFailureMsg(fmt.Sprintf("Expected body to contain %s but it did not", quoteTruncated(substring, truncateEnd)), response))
+	}
+}
+
+/*
+Serves a request with the given method, URL and body, which may be nil, with the given handler
+and asserts that the response has the expected status code.
+Returns the recorded response so that further assertions can be run on it
+*/
+func HTTPHandlerReturns(t testing.TB, handler http.Handler, method string, url string, body io.Reader, expectedStatus int) *http.Response {
+	t.Helper()
+
+	request := httptest.NewRequest(method, url, body)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	response := recorder.Result()
+	response.Request = request
+	StatusCode(t, response, expectedStatus)
+
+	return response
+}
+
+func checkResponse(t testing.TB, response *http.Response) bool {
+	t.Helper()
+
+	if response == nil {
+		fail(t, "Expected a response but got nil")
+		return false
+	}
+
+	return true
+}
+
+/*
+Reads the body of the given response and replaces it with an in-memory copy so that it can be read again
+*/
+func readResponseBody(t testing.TB, response *http.Response) (string, bool) {
+	t.Helper()
+
+	if !checkResponse(t, response) {
+		return "", false
+	}
+
+	body, err := bufferResponseBody(response)
+	if err != nil {
+		fail(t, httpFailureMsg(fmt.Sprintf("Could not read the body: %v", err), response))
+		return "", false
+	}
+
+	return string(body), true
+}
+
+func bufferResponseBody(response *http.Response) ([]byte, error) {
+	if response.Body == nil || response.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, err
+}
+
+/*
+Reads at most enough of the body of the given response to display it and puts the read bytes back in front of the rest,
+so that failure messages do not load large bodies in memory. The read blocks until enough bytes are received or the body ends,
+so a streaming response that sends less than that without ending delays the failure until it does
+*/
+func peekResponseBody(response *http.Response) ([]byte, error) {
+	if response.Body == nil || response.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body := response.Body
+	prefix, err := io.ReadAll(io.LimitReader(body, maxDisplayedBodyLength+1))
+	response.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(prefix), body), body}
+
+	return prefix, err
+}
+
+/*
+Appends a description of the given response, in the format of an HTTP exchange, to the given failure message
+*/
+func httpFailureMsg(msg string, response *http.Response) string {
+	var b strings.Builder
+	b.WriteString(msg)
+	b.WriteString("\n")
+
+	if request := response.Request; request != nil {
+		fmt.Fprintf(&b, "\n%s %s", request.Method, request.URL)
+	}
+
+	status := response.Status
+	if status == "" {
+		status = fmt.Sprintf("%d %s", response.StatusCode, http.StatusText(response.StatusCode))
+	}
+	proto := response.Proto
+	if proto == "" {
+		proto = "HTTP/1.1"
+	}
+	fmt.Fprintf(&b, "\n%s %s", proto, status)
+	names := make([]string, 0, len(response.Header))
+	for name := range response.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range response.Header[name] {
+			fmt.Fprintf(&b, "\n%s: %s", name, value)
+		}
+	}
+
+	body, err := peekResponseBody(response)
+	switch {
+	case err != nil:
+		fmt.Fprintf(&b, "\n\n(body could not be read: %v)", err)
+	case len(body) > 0:
+		b.WriteString("\n\n")
+		b.WriteString(truncatedBody(body))
+	}
+
+	return b.String()
+}
+
+/*
+Formats the start of a body read by peekResponseBody, which holds one byte more than can be displayed when the body is longer
+*/
+func truncatedBody(body []byte) string {
+	truncated := len(body) > maxDisplayedBodyLength
+	if truncated {
+		end := maxDisplayedBodyLength
+		for end > 0 && !utf8.RuneStart(body[end]) {
+			end--
+		}
+		body = body[:end]
+	}
+
+	switch {
+	case !utf8.Valid(body) && truncated:
+		return fmt.Sprintf("(more than %d bytes of binary data)", maxDisplayedBodyLength)
+	case !utf8.Valid(body):
+		return fmt.Sprintf("(%d bytes of binary data)", len(body))
+	case truncated:
+		return fmt.Sprintf("%s... (truncated after %d bytes)", body, len(body))
+	}
+
+	return string(body)
+}
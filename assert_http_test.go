@@ -0,0 +1,170 @@
+package goassert
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func newMockHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/1" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"error":"not found"}`)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Add("Cache-Control", "no-store")
+		io.WriteString(w, `{"id":1,"name":"Ann"}`)
+	})
+}
+
+func Test_HTTPHandlerReturnsShouldReturnResponse_WhenStatusMatches(t *testing.T) {
+	tester := new(testing.T)
+
+	response := HTTPHandlerReturns(tester, newMockHandler(), http.MethodGet, "/users/1", nil, http.StatusOK)
+
+	if tester.Failed() || response == nil || response.StatusCode != http.StatusOK {
+		t.Error("HTTPHandlerReturns did not pass and return the response when the status matched")
+	}
+}
+
+func Test_HTTPHandlerReturnsShouldReportExchange_WhenStatusDiffers(t *testing.T) {
+	tester := newRecordingT()
+
+	HTTPHandlerReturns(tester, newMockHandler(), http.MethodGet, "/users/2", nil, http.StatusOK)
+
+	expected := "Expected status code 200 but got 404\n\n" +
+		"GET /users/2\n" +
+		"HTTP/1.1 404 Not Found\n" +
+		"Content-Type: application/json\n\n" +
+		`{"error":"not found"}`
+	if tester.output() != expected {
+		t.Errorf("HTTPHandlerReturns did not report the exchange but got:\n%s", tester.output())
+	}
+}
+
+func Test_StatusCodeShouldFail_GivenNilResponse(t *testing.T) {
+	tester := new(testing.T)
+
+	StatusCode(tester, nil, http.StatusOK)
+
+	if !tester.Failed() {
+		t.Error("StatusCode did not fail when the response was nil")
+	}
+}
+
+func Test_HeaderEqualShouldPass_GivenMatchingHeader(t *testing.T) {
+	tester := new(testing.T)
+	response := HTTPHandlerReturns(tester, newMockHandler(), http.MethodGet, "/users/1", nil, http.StatusOK)
+
+	HeaderEqual(tester, response, "cache-control", "no-store")
+
+	if tester.Failed() {
+		t.Error("HeaderEqual did not pass when the header matched")
+	}
+}
+
+func Test_HeaderEqualShouldFail_GivenMissingHeader(t *testing.T) {
+	tester := newRecordingT()
+	response := HTTPHandlerReturns(tester, newMockHandler(), http.MethodGet, "/users/1", nil, http.StatusOK)
+
+	HeaderEqual(tester, response, "ETag", "v1")
+
+	if !strings.HasPrefix(tester.output(), `Expected header ETag to be "v1" but it was not set`) {
+		t.Errorf("HeaderEqual did not report the missing header but got:\n%s", tester.output())
+	}
+}
+
+func Test_HeaderContainsShouldPass_GivenHeaderContainingSubstring(t *testing.T) {
+	tester := new(testing.T)
+	response := HTTPHandlerReturns(tester, newMockHandler(), http.MethodGet, "/users/1", nil, http.StatusOK)
+
+	HeaderContains(tester, response, "Content-Type", "application/json")
+
+	if tester.Failed() {
+		t.Error("HeaderContains did not pass when the header contained the substring")
+	}
+}
+
+func Test_HeaderContainsShouldFail_GivenHeaderNotContainingSubstring(t *testing.T) {
+	tester := new(testing.T)
+	response := HTTPHandlerReturns(tester, newMockHandler(), http.MethodGet, "/users/1", nil, http.StatusOK)
+
+	HeaderContains(tester, response, "Content-Type", "text/html")
+
+	if !tester.Failed() {
+		t.Error("HeaderContains did not fail when the header did not contain the substring")
+	}
+}
+
+func Test_BodyAssertionsShouldPass_GivenMatchingBodyReadSeveralTimes(t *testing.T) {
+	tester := new(testing.T)
+	response := HTTPHandlerReturns(tester, newMockHandler(), http.MethodGet, "/users/1", nil, http.StatusOK)
+
+	BodyEqual(tester, response, `{"id":1,"name":"Ann"}`)
+	BodyJSONEq(tester, response, `{"name": "Ann", "id": 1.0}`)
+	BodyContains(tester, response, `"Ann"`)
+
+	if tester.Failed() {
+		t.Error("The body assertions did not pass when the body matched")
+	}
+}
+
+func Test_BodyEqualShouldFail_GivenDifferentBody(t *testing.T) {
+	tester := newRecordingT()
+	response := HTTPHandlerReturns(tester, newMockHandler(), http.MethodGet, "/users/1", nil, http.StatusOK)
+
+	BodyEqual(tester, response, `{"id":2}`)
+
+	if !strings.HasPrefix(tester.output(), `Expected: {"id":2}. Actual: {"id":1,"name":"Ann"}`) {
+		t.Errorf("BodyEqual did not report the different body but got:\n%s", tester.output())
+	}
+}
+
+func Test_BodyJSONEqShouldReportPointer_GivenDifferentJSONBody(t *testing.T) {
+	tester := newRecordingT()
+	response := HTTPHandlerReturns(tester, newMockHandler(), http.MethodGet, "/users/1", nil, http.StatusOK)
+
+	BodyJSONEq(tester, response, `{"id":1,"name":"Bob"}`)
+
+	if !strings.Contains(tester.output(), `/name: expected "Bob" but got "Ann"`) {
+		t.Errorf("BodyJSONEq did not report the JSON pointer but got:\n%s", tester.output())
+	}
+}
+
+func Test_BodyContainsShouldTruncateBody_GivenLongBody(t *testing.T) {
+	tester := newRecordingT()
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, strings.Repeat("x", 2000))
+	})
+	response := HTTPHandlerReturns(tester, handler, http.MethodPost, "/upload", strings.NewReader("data"), http.StatusOK)
+
+	BodyContains(tester, response, "y")
+
+	if !strings.Contains(tester.output(), "POST /upload") || !strings.HasSuffix(tester.output(), "... (truncated after 1024 bytes)") {
+		t.Errorf("BodyContains did not truncate the body but got:\n%s", tester.output())
+	}
+}
+
+func Test_StatusCodeShouldNotConsumeStreamingBody_WhenStatusDiffers(t *testing.T) {
+	tester := newRecordingT()
+	reader, writer := io.Pipe()
+	defer writer.Close()
+	go io.WriteString(writer, strings.Repeat("x", 2000))
+	response := &http.Response{StatusCode: http.StatusOK, Body: reader}
+
+	StatusCode(tester, response, http.StatusCreated)
+
+	if !strings.HasSuffix(tester.output(), "... (truncated after 1024 bytes)") {
+		t.Errorf("StatusCode did not report the start of the streaming body but got:\n%s", tester.output())
+	}
+
+	body := make([]byte, 2000)
+	if _, err := io.ReadFull(response.Body, body); err != nil || string(body) != strings.Repeat("x", 2000) {
+		t.Errorf("StatusCode did not keep the streaming body readable, err: %v", err)
+	}
+}
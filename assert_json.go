@@ -0,0 +1,323 @@
+package goassert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+/*
+Asserts that the two given JSON documents are semantically equal.
+Key order and whitespace are ignored and numbers are compared by value, so 1, 1.0 and 1e0 are equal.
+Differences are reported with their JSON pointer, e.g. /items/2/price
+*/
+func JSONEq(t testing.TB, expected string, actual string) {
+	t.Helper()
+
+	expectedValue, actualValue, ok := decodeJSONDocuments(t, expected, actual)
+	if !ok {
+		return
+	}
+
+	differences := jsonDifferences(expectedValue, actualValue, false)
+	if len(differences) > 0 {
+		fail(t, jsonDifferencesMsg("Expected JSON documents to be equal but they differ at:", differences))
+	}
+}
+
+/*
+Asserts that the actual JSON document contains the expected JSON document.
+Every key of an expected object must be present in the corresponding actual object with a matching value,
+while additional keys in actual are ignored. Arrays are matched element by element and must have the same length
+*/
+func JSONContains(t testing.TB, expected string, actual string) {
+	t.Helper()
+
+	expectedValue, actualValue, ok := decodeJSONDocuments(t, expected, actual)
+	if !ok {
+		return
+	}
+
+	differences := jsonDifferences(expectedValue, actualValue, true)
+	if len(differences) > 0 {
+		fail(t, jsonDifferencesMsg("Expected JSON document to contain the expected document but it differs at:", differences))
+	}
+}
+
+/*
+Asserts that the value at the given path of the given JSON document equals the given expected value.
+The expected value is marshaled with encoding/json before being compared the same way as in [JSONEq].
+The path supports the root $, child keys .name or ['name'] and array indexes [0], e.g. $.items[2].price
+*/
+func JSONPathEqual(t testing.TB, document string, path string, expected interface{}) {
+	t.Helper()
+
+	documentValue, err := decodeJSON(document)
+	if err != nil {
+		failf(t, "Document is not valid JSON: %v", err)
+		return
+	}
+
+	actualValue, err := resolveJSONPath(documentValue, path)
+	if err != nil {
+		failf(t, "JSON path %s could not be resolved: %v", path, err)
+		return
+	}
+
+	marshaled, err := json.Marshal(expected)
+	if err != nil {
+		failf(t, "Expected value could not be marshaled to JSON: %v", err)
+		return
+	}
+	expectedValue, _ := decodeJSON(string(marshaled))
+
+	if len(jsonDifferences(expectedValue, actualValue, false)) > 0 {
+		failf(t, "Expected %s at JSON path %s but got %s", compactJSON(expectedValue), path, compactJSON(actualValue))
+	}
+}
+
+func decodeJSONDocuments(t testing.TB, expected string, actual string) (interface{}, interface{}, bool) {
+	t.Helper()
+
+	expectedValue, err := decodeJSON(expected)
+	if err != nil {
+		failf(t, "Expected value is not valid JSON: %v", err)
+		return nil, nil, false
+	}
+
+	actualValue, err := decodeJSON(actual)
+	if err != nil {
+		failf(t, "Actual value is not valid JSON: %v", err)
+		return nil, nil, false
+	}
+
+	return expectedValue, actualValue, true
+}
+
+func decodeJSON(document string) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(document))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	// More reports false before a closing delimiter, so trailing data is detected by reading one more token
+	offset := decoder.InputOffset()
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after the top-level value at offset %d", offset)
+	}
+
+	return value, nil
+}
+
+/*
+Compares two decoded JSON values and returns a description of each difference prefixed by its JSON pointer.
+In subset mode, keys of actual objects that are not in the expected objects are ignored
+*/
+func jsonDifferences(expected interface{}, actual interface{}, subset bool) []string {
+	var differences []string
+	collectJSONDifferences(expected, actual, "", subset, &differences)
+
+	return differences
+}
+
+func collectJSONDifferences(expected interface{}, actual interface{}, pointer string, subset bool, differences *[]string) {
+	mismatch := func() {
+		*differences = append(*differences,
+			fmt.Sprintf("%s: expected %s but got %s", displayPointer(pointer), compactJSON(expected), compactJSON(actual)))
+	}
+
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			mismatch()
+			return
+		}
+
+		for _, key := range sortedJSONKeys(e) {
+			childPointer := pointer + "/" + escapeJSONPointer(key)
+			actualChild, found := a[key]
+			if !found {
+				*differences = append(*differences, fmt.Sprintf("%s: missing in actual", childPointer))
+				continue
+			}
+			collectJSONDifferences(e[key], actualChild, childPointer, subset, differences)
+		}
+
+		if subset {
+			return
+		}
+		for _, key := range sortedJSONKeys(a) {
+			if _, found := e[key]; !found {
+				*differences = append(*differences, fmt.Sprintf("%s/%s: unexpected in actual", pointer, escapeJSONPointer(key)))
+			}
+		}
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok {
+			mismatch()
+			return
+		}
+
+		if len(e) != len(a) {
+			*differences = append(*differences,
+				fmt.Sprintf("%s: expected array of length %d but got %d", displayPointer(pointer), len(e), len(a)))
+		}
+		for i := 0; i < len(e) && i < len(a); i++ {
+			collectJSONDifferences(e[i], a[i], pointer+"/"+strconv.Itoa(i), subset, differences)
+		}
+	case json.Number:
+		a, ok := actual.(json.Number)
+		if !ok || !equalJSONNumbers(e, a) {
+			mismatch()
+		}
+	default:
+		if expected != actual {
+			mismatch()
+		}
+	}
+}
+
+func equalJSONNumbers(expected json.Number, actual json.Number) bool {
+	expectedRat, expectedOk := new(big.Rat).SetString(expected.String())
+	actualRat, actualOk := new(big.Rat).SetString(actual.String())
+	if !expectedOk || !actualOk {
+		return expected == actual
+	}
+
+	return expectedRat.Cmp(actualRat) == 0
+}
+
+func sortedJSONKeys(object map[string]interface{}) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func escapeJSONPointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+func displayPointer(pointer string) string {
+	if pointer == "" {
+		return "(root)"
+	}
+	return pointer
+}
+
+func compactJSON(value interface{}) string {
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func jsonDifferencesMsg(header string, differences []string) string {
+	var b strings.Builder
+	b.WriteString(header)
+	for i, difference := range differences {
+		if i == maxDiffPaths {
+			fmt.Fprintf(&b, "\n\t... and %d more", len(differences)-maxDiffPaths)
+			break
+		}
+		b.WriteString("\n\t")
+		b.WriteString(difference)
+	}
+
+	return b.String()
+}
+
+/*
+Resolves a path such as $.items[2]['unit price'] against the given decoded JSON value
+*/
+func resolveJSONPath(document interface{}, path string) (interface{}, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("path must start with $")
+	}
+
+	current := document
+	rest := path[1:]
+	resolved := "$"
+	for rest != "" {
+		var segment string
+		var index int
+		isIndex := false
+
+		switch {
+		case rest[0] == '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			segment = rest[1 : end+1]
+			if segment == "" {
+				return nil, fmt.Errorf("empty key after %s", resolved)
+			}
+			rest = rest[end+1:]
+		case rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed bracket after %s", resolved)
+			}
+			inner := rest[1:end]
+			rest = rest[end+1:]
+
+			if len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0] {
+				segment = inner[1 : len(inner)-1]
+				break
+			}
+
+			i, err := strconv.Atoi(inner)
+			if err != nil {
+				return nil, fmt.Errorf("invalid index [%s] after %s", inner, resolved)
+			}
+			index = i
+			isIndex = true
+		default:
+			return nil, fmt.Errorf("unexpected %q after %s", rest[0], resolved)
+		}
+
+		if isIndex {
+			array, ok := current.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s is not an array", resolved)
+			}
+			if index < 0 || index >= len(array) {
+				return nil, fmt.Errorf("index %d is out of range for %s with length %d", index, resolved, len(array))
+			}
+			current = array[index]
+			resolved += fmt.Sprintf("[%d]", index)
+			continue
+		}
+
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s is not an object", resolved)
+		}
+		value, found := object[segment]
+		if !found {
+			return nil, fmt.Errorf("key %q not found in %s", segment, resolved)
+		}
+		current = value
+		resolved += "." + segment
+	}
+
+	return current, nil
+}
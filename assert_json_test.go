@@ -0,0 +1,152 @@
+package goassert
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_JSONEqShouldPass_GivenDocumentsWithDifferentKeyOrderAndWhitespace(t *testing.T) {
+	tester := new(testing.T)
+
+	JSONEq(tester, `{"name": "ann", "items": [1, 2]}`, `{"items":[1,2],"name":"ann"}`)
+
+	if tester.Failed() {
+		t.Error("JSONEq did not pass when given documents with different key order and whitespace")
+	}
+}
+
+func Test_JSONEqShouldPass_GivenNumbersWithDifferentRepresentations(t *testing.T) {
+	tester := new(testing.T)
+
+	JSONEq(tester, `{"price": 10, "ratio": 0.5}`, `{"price": 10.0, "ratio": 5e-1}`)
+
+	if tester.Failed() {
+		t.Error("JSONEq did not pass when given numbers with different representations")
+	}
+}
+
+func Test_JSONEqShouldReportPointer_GivenDocumentsWithDifferentNestedValue(t *testing.T) {
+	tester := newRecordingT()
+
+	JSONEq(tester,
+		`{"items": [{"price": 1}, {"price": 2}, {"price": 3}]}`,
+		`{"items": [{"price": 1}, {"price": 2}, {"price": 4}]}`)
+
+	if !tester.Failed() || !strings.Contains(tester.output(), "/items/2/price: expected 3 but got 4") {
+		t.Errorf("JSONEq did not report the pointer of the difference but got %q", tester.output())
+	}
+}
+
+func Test_JSONEqShouldReportMissingAndUnexpectedKeys_GivenDocumentsWithDifferentKeys(t *testing.T) {
+	tester := newRecordingT()
+
+	JSONEq(tester, `{"a/b": 1, "c": 2}`, `{"c": 2, "d": 3}`)
+
+	for _, expected := range []string{"/a~1b: missing in actual", "/d: unexpected in actual"} {
+		if !strings.Contains(tester.output(), expected) {
+			t.Errorf("JSONEq did not report %q but got %q", expected, tester.output())
+		}
+	}
+}
+
+func Test_JSONEqShouldFail_GivenNumberAndString(t *testing.T) {
+	tester := new(testing.T)
+
+	JSONEq(tester, `{"id": 1}`, `{"id": "1"}`)
+
+	if !tester.Failed() {
+		t.Error("JSONEq did not fail when given a number and a string")
+	}
+}
+
+func Test_JSONEqShouldFail_GivenInvalidJSON(t *testing.T) {
+	tester := new(testing.T)
+
+	JSONEq(tester, `{"id": 1}`, `{"id": 1`)
+
+	if !tester.Failed() {
+		t.Error("JSONEq did not fail when given invalid JSON")
+	}
+}
+
+func Test_JSONEqShouldFail_GivenTrailingData(t *testing.T) {
+	for _, actual := range []string{`{"id": 1}}`, `{"id": 1}]`, `{"id": 1} 2`} {
+		tester := new(testing.T)
+
+		JSONEq(tester, `{"id": 1}`, actual)
+
+		if !tester.Failed() {
+			t.Errorf("JSONEq did not fail when given trailing data in %s", actual)
+		}
+	}
+}
+
+func Test_JSONEqShouldPass_GivenTrailingWhitespace(t *testing.T) {
+	tester := new(testing.T)
+
+	JSONEq(tester, `{"id": 1}`, "{\"id\": 1}\n\t ")
+
+	if tester.Failed() {
+		t.Error("JSONEq did not pass when given trailing whitespace")
+	}
+}
+
+func Test_JSONContainsShouldPass_WhenActualHasAdditionalKeys(t *testing.T) {
+	tester := new(testing.T)
+
+	JSONContains(tester, `{"user": {"name": "ann"}}`, `{"id": 7, "user": {"name": "ann", "age": 30}}`)
+
+	if tester.Failed() {
+		t.Error("JSONContains did not pass when actual had additional keys")
+	}
+}
+
+func Test_JSONContainsShouldReportPointer_WhenExpectedKeyIsMissing(t *testing.T) {
+	tester := newRecordingT()
+
+	JSONContains(tester, `{"user": {"email": "ann@example.com"}}`, `{"user": {"name": "ann"}}`)
+
+	if !tester.Failed() || !strings.Contains(tester.output(), "/user/email: missing in actual") {
+		t.Errorf("JSONContains did not report the missing key but got %q", tester.output())
+	}
+}
+
+func Test_JSONPathEqualShouldPass_WhenValueAtPathMatches(t *testing.T) {
+	tester := new(testing.T)
+
+	JSONPathEqual(tester, `{"a": {"b": [{"c": 10}, 2]}}`, "$.a.b[0]", map[string]int{"c": 10})
+
+	if tester.Failed() {
+		t.Error("JSONPathEqual did not pass when the value at the path matched")
+	}
+}
+
+func Test_JSONPathEqualShouldPass_GivenQuotedKey(t *testing.T) {
+	tester := new(testing.T)
+
+	JSONPathEqual(tester, `{"unit price": 9.5}`, "$['unit price']", 9.5)
+
+	if tester.Failed() {
+		t.Error("JSONPathEqual did not pass when given a quoted key")
+	}
+}
+
+func Test_JSONPathEqualShouldFail_WhenValueAtPathDiffers(t *testing.T) {
+	tester := newRecordingT()
+
+	JSONPathEqual(tester, `{"a": {"b": ["x", "y"]}}`, "$.a.b[1]", "z")
+
+	if !tester.Failed() || tester.output() != `Expected "z" at JSON path $.a.b[1] but got "y"` {
+		t.Errorf("JSONPathEqual did not fail with the expected message but got %q", tester.output())
+	}
+}
+
+func Test_JSONPathEqualShouldFail_WhenPathCannotBeResolved(t *testing.T) {
+	tester := newRecordingT()
+
+	JSONPathEqual(tester, `{"a": [1]}`, "$.a[3]", 1)
+
+	if !tester.Failed() || !strings.Contains(tester.output(), "index 3 is out of range for $.a") {
+		t.Errorf("JSONPathEqual did not report the unresolved path but got %q", tester.output())
+	}
+}
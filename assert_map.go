@@ -1,6 +1,10 @@
 package goassert
 
-import "testing"
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
 
 /*
 Asserts that the given map is empty. The assertion will fail if the given map is nil
@@ -9,13 +13,13 @@ func EmptyMap[K comparable, V any](t testing.TB, m map[K]V) {
 	t.Helper()
 
 	if m == nil {
-		t.Error("Expected empty map but got nil")
+		fail(t, "Expected empty map but got nil")
 		return
 	}
 
 	length := len(m)
 	if length != 0 {
-		t.Errorf("Expected empty map but got map with length of %d", length)
+		failf(t, "Expected empty map but got map with length of %d", length)
 	}
 }
 
@@ -26,12 +30,12 @@ func NotEmptyMap[K comparable, V any](t testing.TB, m map[K]V) {
 	t.Helper()
 
 	if m == nil {
-		t.Error("Expected non-empty map but got nil")
+		fail(t, "Expected non-empty map but got nil")
 		return
 	}
 
 	if len(m) == 0 {
-		t.Error("Expected non-empty map but got empty map")
+		fail(t, "Expected non-empty map but got empty map")
 	}
 }
 
@@ -43,7 +47,7 @@ func MapLength[K comparable, V any](t testing.TB, m map[K]V, expectedLength int)
 
 	length := len(m)
 	if length != expectedLength {
-		t.Errorf("Expected map to have length of %d but got %d", expectedLength, length)
+		failf(t, "Expected map to have length of %d but got %d", expectedLength, length)
 	}
 }
 
@@ -55,7 +59,7 @@ func MapContainsKey[K comparable, V any](t testing.TB, m map[K]V, k K) {
 
 	_, found := m[k]
 	if !found {
-		t.Errorf("The given map was expected to contain key %v but did not", k)
+		failf(t, "The given map was expected to contain key %v but did not", k)
 	}
 }
 
@@ -67,7 +71,7 @@ func MapNotContainsKey[K comparable, V any](t testing.TB, m map[K]V, k K) {
 
 	_, found := m[k]
 	if found {
-		t.Errorf("The given map was expected to not contain key %v but did", k)
+		failf(t, "The given map was expected to not contain key %v but did", k)
 	}
 }
 
@@ -80,12 +84,12 @@ func MapContains[K, V comparable](t testing.TB, m map[K]V, k K, v V) {
 	actualValue, found := m[k]
 
 	if !found {
-		t.Errorf("Key %v was not found in the map", k)
+		failf(t, "Key %v was not found in the map", k)
 		return
 	}
 
 	if v != actualValue {
-		t.Errorf("Expected %v for key %v in the map but got %v", v, k, actualValue)
+		failf(t, "Expected %v for key %v in the map but got %v", v, k, actualValue)
 	}
 }
 
@@ -98,6 +102,98 @@ func MapNotContains[K, V comparable](t testing.TB, m map[K]V, k K, v V) {
 	value, found := m[k]
 
 	if found && v == value {
-		t.Errorf("Key %v and value %v was not expected to be found in the map", k, v)
+		failf(t, "Key %v and value %v was not expected to be found in the map", k, v)
+	}
+}
+
+/*
+Asserts that every key-value pair of expected can be found in actual, i.e. expected is a subset of actual.
+The keys and values must be [comparable]
+*/
+func MapSubset[K, V comparable](t testing.TB, expected map[K]V, actual map[K]V) {
+	t.Helper()
+
+	var missingKeys []K
+	var differentValues []string
+	for _, k := range sortedKeys(expected) {
+		actualValue, found := actual[k]
+		if !found {
+			missingKeys = append(missingKeys, k)
+			continue
+		}
+
+		if actualValue != expected[k] {
+			differentValues = append(differentValues, fmt.Sprintf("key %v: expected %v but got %v", k, expected[k], actualValue))
+		}
+	}
+
+	if len(missingKeys) == 0 && len(differentValues) == 0 {
+		return
+	}
+
+	var b strings.Builder
+	b.WriteString("Expected the map to contain all expected key-value pairs but it did not")
+	if len(missingKeys) > 0 {
+		fmt.Fprintf(&b, "\n\tmissing keys: %v", missingKeys)
+	}
+	for _, differentValue := range differentValues {
+		fmt.Fprintf(&b, "\n\t%s", differentValue)
+	}
+
+	fail(t, b.String())
+}
+
+/*
+Asserts that the two given maps have exactly the same keys. The values are not compared. The keys must be [comparable]
+*/
+func MapKeysEqual[K comparable, V any](t testing.TB, expected map[K]V, actual map[K]V) {
+	t.Helper()
+
+	missingKeys := mapKeysNotIn(expected, actual)
+	unexpectedKeys := mapKeysNotIn(actual, expected)
+	if len(missingKeys) == 0 && len(unexpectedKeys) == 0 {
+		return
+	}
+
+	var b strings.Builder
+	b.WriteString("Expected the map keys to equal the expected keys but they did not")
+	if len(missingKeys) > 0 {
+		fmt.Fprintf(&b, "\n\tmissing keys: %v", missingKeys)
+	}
+	if len(unexpectedKeys) > 0 {
+		fmt.Fprintf(&b, "\n\tunexpected keys: %v", unexpectedKeys)
+	}
+
+	fail(t, b.String())
+}
+
+/*
+Returns the keys of from that are not in other, sorted for a deterministic output
+*/
+func mapKeysNotIn[K comparable, V any](from map[K]V, other map[K]V) []K {
+	var keys []K
+	for _, k := range sortedKeys(from) {
+		if _, found := other[k]; !found {
+			keys = append(keys, k)
+		}
+	}
+
+	return keys
+}
+
+/*
+Asserts that the given map contains the given key and that its value satisfies the given matcher
+*/
+func MapContainsMatch[K comparable, V any](t testing.TB, m map[K]V, k K, matcher Matcher[V]) {
+	t.Helper()
+
+	actualValue, found := m[k]
+	if !found {
+		failf(t, "Key %v was not found in the map", k)
+		return
+	}
+
+	if !matcher.Match(actualValue) {
+		failf(t, "Expected a value %s for key %v in the map but it %s", matcher.Describe(), k, matcher.DescribeMismatch(actualValue))
 	}
 }
@@ -1,6 +1,9 @@
 package goassert
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func Test_EmptyMapShouldPass_GivenEmptyMap(t *testing.T) {
 	tester := new(testing.T)
@@ -258,3 +261,97 @@ func Test_MapNotContainsShouldFail_IfGivenKeyValuePairExistsInGivenMap(t *testin
 		t.Error("MapContains did not fail when given key value pair is found in given map")
 	}
 }
+
+func Test_MapSubsetShouldPass_WhenAllExpectedPairsAreInActual(t *testing.T) {
+	tester := new(testing.T)
+
+	MapSubset(tester, map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2})
+
+	if tester.Failed() {
+		t.Error("MapSubset did not pass when all expected key-value pairs were in actual")
+	}
+}
+
+func Test_MapSubsetShouldReportMissingKeysAndDifferentValues_WhenExpectedPairsAreNotInActual(t *testing.T) {
+	tester := newRecordingT()
+
+	MapSubset(tester, map[string]int{"a": 1, "b": 2, "c": 3}, map[string]int{"a": 1, "b": 5})
+
+	for _, expected := range []string{"missing keys: [c]", "key b: expected 2 but got 5"} {
+		if !strings.Contains(tester.output(), expected) {
+			t.Errorf("MapSubset did not report %q but got %q", expected, tester.output())
+		}
+	}
+}
+
+func Test_MapKeysEqualShouldPass_GivenMapsWithSameKeysAndDifferentValues(t *testing.T) {
+	tester := new(testing.T)
+
+	MapKeysEqual(tester, map[int]string{1: "a", 2: "b"}, map[int]string{2: "c", 1: "d"})
+
+	if tester.Failed() {
+		t.Error("MapKeysEqual did not pass when given maps with same keys")
+	}
+}
+
+func Test_MapKeysEqualShouldReportMissingAndUnexpectedKeys_GivenMapsWithDifferentKeys(t *testing.T) {
+	tester := newRecordingT()
+
+	MapKeysEqual(tester, map[int]bool{1: true, 2: true, 3: true}, map[int]bool{1: true, 10: true})
+
+	for _, expected := range []string{"missing keys: [2 3]", "unexpected keys: [10]"} {
+		if !strings.Contains(tester.output(), expected) {
+			t.Errorf("MapKeysEqual did not report %q but got %q", expected, tester.output())
+		}
+	}
+}
+
+func Test_MapSubsetShouldPass_GivenNilInterfaceKey(t *testing.T) {
+	tester := new(testing.T)
+
+	MapSubset(tester, map[interface{}]int{nil: 1}, map[interface{}]int{nil: 1, "a": 2})
+
+	if tester.Failed() {
+		t.Error("MapSubset did not pass when given a nil interface key in both maps")
+	}
+}
+
+func Test_MapKeysEqualShouldReportMissingNilKey_GivenNilInterfaceKey(t *testing.T) {
+	tester := newRecordingT()
+
+	MapKeysEqual(tester, map[interface{}]int{nil: 1, 2: 2}, map[interface{}]int{2: 2})
+
+	if !strings.Contains(tester.output(), "missing keys: [<nil>]") {
+		t.Errorf("MapKeysEqual did not report the missing nil key but got %q", tester.output())
+	}
+}
+
+func Test_MapContainsMatchShouldPass_GivenKeyWithMatchingValue(t *testing.T) {
+	tester := new(testing.T)
+
+	MapContainsMatch(tester, map[string][]int{"a": {1, 2}}, "a", HasLen[[]int](2))
+
+	if tester.Failed() {
+		t.Error("MapContainsMatch did not pass when the value of the key matched")
+	}
+}
+
+func Test_MapContainsMatchShouldFail_GivenKeyWithMismatchingValue(t *testing.T) {
+	tester := new(testing.T)
+
+	MapContainsMatch(tester, map[string]int{"a": 1}, "a", GreaterThan(1))
+
+	if !tester.Failed() {
+		t.Error("MapContainsMatch did not fail when the value of the key did not match")
+	}
+}
+
+func Test_MapContainsMatchShouldFail_GivenMissingKey(t *testing.T) {
+	tester := new(testing.T)
+
+	MapContainsMatch(tester, map[string]int{"a": 1}, "b", GreaterThan(0))
+
+	if !tester.Failed() {
+		t.Error("MapContainsMatch did not fail when the key was missing")
+	}
+}
@@ -0,0 +1,233 @@
+package goassert
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+/*
+Asserts that the actual value matches the fields that are set in the expected value.
+Zero-valued fields of expected, such as empty strings, zero numbers and nil pointers, slices and maps, are ignored recursively,
+so only the fields of interest have to be filled in. Non-nil slices are compared element by element and must have the same length.
+Use [MatchMap] to assert that a field is zero
+*/
+func MatchFields[T any](t testing.TB, expected T, actual T) {
+	t.Helper()
+
+	config := equalConfig{ignoreZeroExpected: true}
+	differences := diffValues(reflect.ValueOf(&expected).Elem(), reflect.ValueOf(&actual).Elem(), &config)
+	if len(differences) > 0 {
+		fail(t, differencesMsg("Expected actual to match the non-zero fields of expected", differences))
+	}
+}
+
+/*
+Asserts that the fields of the given value at the given paths equal the expected values.
+A path is made of field names separated by dots, with optional indexes for slices and arrays, e.g. "Users[0].Address.City".
+Pointers and interfaces are followed. Numbers and strings are compared by value when the expected value can be converted
+to the type of the field without loss, so an untyped 30 matches an int64 field and "active" matches a field of a named string type
+*/
+func MatchMap(t testing.TB, expected map[string]interface{}, actual interface{}) {
+	t.Helper()
+
+	paths := make([]string, 0, len(expected))
+	for path := range expected {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var mismatches []string
+	for _, path := range paths {
+		field, err := resolveFieldPath(reflect.ValueOf(actual), path)
+		if err != nil {
+			mismatches = append(mismatches, fmt.Sprintf("%s: %v", path, err))
+			continue
+		}
+
+		if !fieldEquals(expected[path], field) {
+			mismatches = append(mismatches, fmt.Sprintf("%s: expected %s but got %s",
+				path, describeValue(expected[path]), prettyPrintValue(field, true)))
+		}
+	}
+
+	if len(mismatches) > 0 {
+		var b strings.Builder
+		b.WriteString("Expected the fields of actual to match but they did not:")
+		for _, mismatch := range mismatches {
+			b.WriteString("\n\t")
+			b.WriteString(mismatch)
+		}
+		fail(t, b.String())
+	}
+}
+
+/*
+Resolves a path such as Users[0].Address.City against the given value
+*/
+func resolveFieldPath(value reflect.Value, path string) (reflect.Value, error) {
+	current := value
+	resolved := ""
+	for _, segment := range strings.Split(path, ".") {
+		name := segment
+		indexes := ""
+		if bracket := strings.IndexByte(segment, '['); bracket >= 0 {
+			name, indexes = segment[:bracket], segment[bracket:]
+		}
+
+		current = indirect(current)
+		if !current.IsValid() {
+			return reflect.Value{}, fmt.Errorf("%s is nil", displayFieldPath(resolved))
+		}
+		if current.Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf("%s is a %v, not a struct", displayFieldPath(resolved), current.Type())
+		}
+
+		field, found := current.Type().FieldByName(name)
+		if !found {
+			return reflect.Value{}, fmt.Errorf("field %s not found in %v", name, current.Type())
+		}
+		if !field.IsExported() {
+			return reflect.Value{}, fmt.Errorf("field %s of %v is unexported", name, current.Type())
+		}
+
+		var err error
+		current, err = current.FieldByIndexErr(field.Index)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		resolved = joinPath(resolved, name)
+
+		for indexes != "" {
+			end := strings.IndexByte(indexes, ']')
+			if indexes[0] != '[' || end < 0 {
+				return reflect.Value{}, fmt.Errorf("invalid index %s after %s", indexes, resolved)
+			}
+			index, err := strconv.Atoi(indexes[1:end])
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("invalid index %s after %s", indexes[:end+1], resolved)
+			}
+			indexes = indexes[end+1:]
+
+			current = indirect(current)
+			if !current.IsValid() || current.Kind() != reflect.Slice && current.Kind() != reflect.Array {
+				return reflect.Value{}, fmt.Errorf("%s is not a slice or an array", resolved)
+			}
+			if index < 0 || index >= current.Len() {
+				return reflect.Value{}, fmt.Errorf("index %d is out of range for %s with length %d", index, resolved, current.Len())
+			}
+			current = current.Index(index)
+			resolved += fmt.Sprintf("[%d]", index)
+		}
+	}
+
+	return current, nil
+}
+
+/*
+Follows the given pointers and interfaces, returning an invalid value if one of them is nil
+*/
+func indirect(value reflect.Value) reflect.Value {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+
+	return value
+}
+
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func displayFieldPath(path string) string {
+	if path == "" {
+		return "actual"
+	}
+	return path
+}
+
+func fieldEquals(expected interface{}, field reflect.Value) bool {
+	actual := field.Interface()
+	if reflect.DeepEqual(expected, actual) {
+		return true
+	}
+
+	expectedValue := reflect.ValueOf(expected)
+	if !expectedValue.IsValid() {
+		return isNil(actual)
+	}
+	bothNumbers := isNumberKind(expectedValue.Kind()) && isNumberKind(field.Kind())
+	bothStrings := expectedValue.Kind() == reflect.String && field.Kind() == reflect.String
+	if !bothNumbers && !bothStrings {
+		return false
+	}
+
+	if bothNumbers && !numberFits(expectedValue, field) {
+		return false
+	}
+
+	converted := expectedValue.Convert(field.Type())
+	if converted.Convert(expectedValue.Type()).Interface() != expected {
+		return false
+	}
+
+	return reflect.DeepEqual(converted.Interface(), actual)
+}
+
+/*
+Reports whether the given number is within the range of the type of the given field. Conversions out of range
+wrap around or are implementation-defined, so the round-trip check of fieldEquals cannot detect them
+*/
+func numberFits(number reflect.Value, field reflect.Value) bool {
+	switch number.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n := number.Int()
+		switch field.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return !field.OverflowInt(n)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return n >= 0 && !field.OverflowUint(uint64(n))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n := number.Uint()
+		switch field.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return n <= math.MaxInt64 && !field.OverflowInt(int64(n))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return !field.OverflowUint(n)
+		}
+	case reflect.Float32, reflect.Float64:
+		f := number.Float()
+		switch field.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return f >= math.MinInt64 && f < math.MaxInt64 && !field.OverflowInt(int64(f))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return f >= 0 && f < math.MaxUint64 && !field.OverflowUint(uint64(f))
+		case reflect.Float32, reflect.Float64:
+			return math.IsNaN(f) || math.IsInf(f, 0) || !field.OverflowFloat(f)
+		}
+	}
+
+	return true
+}
+
+func isNumberKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,155 @@
+package goassert
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+type mockStatus string
+
+type mockAccount struct {
+	Name    string
+	Age     int64
+	Status  mockStatus
+	Active  bool
+	Owner   *mockUser
+	Members []mockUser
+	secret  string
+}
+
+func newMockAccount() *mockAccount {
+	return &mockAccount{
+		Name:   "Team",
+		Age:    30,
+		Status: "active",
+		Owner:  &mockUser{Name: "Ann", Address: &mockAddress{City: "Oslo", Zip: "0150"}},
+		Members: []mockUser{
+			{Name: "Bob", Address: &mockAddress{City: "Bergen", Zip: "5003"}},
+		},
+		secret: "s3cr3t",
+	}
+}
+
+func Test_MatchFieldsShouldPass_GivenExpectedWithOnlySomeFieldsSet(t *testing.T) {
+	tester := new(testing.T)
+
+	MatchFields(tester, &mockAccount{Name: "Team", Owner: &mockUser{Address: &mockAddress{City: "Oslo"}}}, newMockAccount())
+
+	if tester.Failed() {
+		t.Error("MatchFields did not pass when the set fields of expected matched")
+	}
+}
+
+func Test_MatchFieldsShouldReportPaths_GivenMismatchingSetFields(t *testing.T) {
+	tester := newRecordingT()
+
+	MatchFields(tester, &mockAccount{Name: "Team", Owner: &mockUser{Address: &mockAddress{City: "Bergen"}}}, newMockAccount())
+
+	expected := "Expected actual to match the non-zero fields of expected\nDifferences:\n" +
+		"\t.Owner.Address.City: expected \"Bergen\" but got \"Oslo\""
+	if tester.output() != expected {
+		t.Errorf("MatchFields did not report the mismatching field but got:\n%s", tester.output())
+	}
+}
+
+func Test_MatchFieldsShouldCompareSliceElements_GivenNonNilSlice(t *testing.T) {
+	tester := newRecordingT()
+
+	MatchFields(tester, mockAccount{Members: []mockUser{{Name: "Bob"}, {Name: "Eve"}}}, *newMockAccount())
+
+	if !strings.Contains(tester.output(), ".Members[1]: missing in actual") {
+		t.Errorf("MatchFields did not compare the slice elements but got:\n%s", tester.output())
+	}
+}
+
+func Test_MatchMapShouldPass_GivenMatchingFieldPaths(t *testing.T) {
+	tester := new(testing.T)
+
+	MatchMap(tester, map[string]interface{}{
+		"Name":                    "Team",
+		"Age":                     30,
+		"Status":                  "active",
+		"Active":                  false,
+		"Owner.Address.City":      "Oslo",
+		"Members[0].Address.Zip":  "5003",
+		"Members[0].Address.City": "Bergen",
+	}, newMockAccount())
+
+	if tester.Failed() {
+		t.Error("MatchMap did not pass when the fields matched")
+	}
+}
+
+func Test_MatchMapShouldReportMismatches_GivenMismatchingFieldPaths(t *testing.T) {
+	tester := newRecordingT()
+
+	MatchMap(tester, map[string]interface{}{
+		"Owner.Address.City": "Bergen",
+		"Age":                30.5,
+		"Owner.Email":        "ann@example.com",
+		"Members[3].Name":    "Eve",
+		"secret":             "",
+	}, newMockAccount())
+
+	expected := "Expected the fields of actual to match but they did not:\n" +
+		"\tAge: expected 30.5 but got 30\n" +
+		"\tMembers[3].Name: index 3 is out of range for Members with length 1\n" +
+		"\tOwner.Address.City: expected \"Bergen\" but got \"Oslo\"\n" +
+		"\tOwner.Email: field Email not found in goassert.mockUser\n" +
+		"\tsecret: field secret of goassert.mockAccount is unexported"
+	if tester.output() != expected {
+		t.Errorf("MatchMap did not report the mismatches but got:\n%s", tester.output())
+	}
+}
+
+func Test_MatchMapShouldReportNilPointer_GivenPathThroughNilPointer(t *testing.T) {
+	tester := newRecordingT()
+
+	MatchMap(tester, map[string]interface{}{"Address.City": "Oslo"}, mockUser{Name: "Ann"})
+
+	if tester.output() != "Expected the fields of actual to match but they did not:\n\tAddress.City: Address is nil" {
+		t.Errorf("MatchMap did not report the nil pointer but got:\n%s", tester.output())
+	}
+}
+
+func Test_MatchMapShouldPass_GivenNilForNilField(t *testing.T) {
+	tester := new(testing.T)
+
+	MatchMap(tester, map[string]interface{}{"Address": nil}, mockUser{Name: "Ann"})
+
+	if tester.Failed() {
+		t.Error("MatchMap did not pass when a nil field was expected to be nil")
+	}
+}
+
+func Test_MatchMapShouldFail_GivenNumbersOutOfRangeOfField(t *testing.T) {
+	type counters struct {
+		N     uint
+		Small int8
+		F     float32
+	}
+
+	for _, expected := range []map[string]interface{}{{"N": -1}, {"Small": 127 + 256}, {"F": 1e300}, {"Small": 1e20}} {
+		tester := new(testing.T)
+
+		MatchMap(tester, expected, counters{N: ^uint(0), Small: 127, F: float32(math.Inf(1))})
+
+		if !tester.Failed() {
+			t.Errorf("MatchMap did not fail when given %v out of range of the field", expected)
+		}
+	}
+}
+
+func Test_MatchMapShouldPass_GivenNumbersOfOtherTypesInRangeOfField(t *testing.T) {
+	tester := new(testing.T)
+
+	MatchMap(tester, map[string]interface{}{"N": 7, "F": 0.5}, struct {
+		N uint8
+		F float32
+	}{N: 7, F: 0.5})
+
+	if tester.Failed() {
+		t.Error("MatchMap did not pass when given numbers in range of the fields")
+	}
+}
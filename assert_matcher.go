@@ -0,0 +1,288 @@
+package goassert
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+/*
+Matcher describes an expectation on a value of type T that can be checked with [That]
+and composed with other matchers, e.g. AllOf(GreaterThan(0), Not(Eq(3)))
+*/
+type Matcher[T any] interface {
+	// Match reports whether the given value satisfies the expectation
+	Match(actual T) bool
+	// Describe describes the expected value, e.g. "equal to 3"
+	Describe() string
+	// DescribeMismatch describes why the given value does not satisfy the expectation, e.g. "was 4"
+	DescribeMismatch(actual T) string
+}
+
+/*
+Asserts that the given value satisfies the given matcher
+*/
+func That[T any](t testing.TB, actual T, matcher Matcher[T]) {
+	t.Helper()
+
+	if !matcher.Match(actual) {
+		failf(t, "Expected a value %s but it %s", matcher.Describe(), matcher.DescribeMismatch(actual))
+	}
+}
+
+/*
+Matches values deeply equal to the given value. Internally uses [reflect.DeepEqual]
+*/
+func Eq[T any](expected T) Matcher[T] {
+	return &funcMatcher[T]{
+		description: "equal to " + describeValue(expected),
+		match: func(actual T) bool {
+			return reflect.DeepEqual(expected, actual)
+		},
+	}
+}
+
+/*
+Matches values that do not satisfy the given matcher
+*/
+func Not[T any](matcher Matcher[T]) Matcher[T] {
+	return &funcMatcher[T]{
+		description: "not " + matcher.Describe(),
+		match: func(actual T) bool {
+			return !matcher.Match(actual)
+		},
+	}
+}
+
+/*
+Matches values that satisfy all of the given matchers. The mismatch of the first unsatisfied matcher is reported
+*/
+func AllOf[T any](matchers ...Matcher[T]) Matcher[T] {
+	return &funcMatcher[T]{
+		description: joinDescriptions(matchers, " and "),
+		match: func(actual T) bool {
+			return firstMismatch(matchers, actual) == nil
+		},
+		mismatch: func(actual T) string {
+			if matcher := firstMismatch(matchers, actual); matcher != nil {
+				return matcher.DescribeMismatch(actual)
+			}
+			return "was " + describeValue(actual)
+		},
+	}
+}
+
+/*
+Matches values that satisfy at least one of the given matchers
+*/
+func AnyOf[T any](matchers ...Matcher[T]) Matcher[T] {
+	return &funcMatcher[T]{
+		description: joinDescriptions(matchers, " or "),
+		match: func(actual T) bool {
+			for _, matcher := range matchers {
+				if matcher.Match(actual) {
+					return true
+				}
+			}
+			return false
+		},
+	}
+}
+
+/*
+Matches strings, slices, arrays, maps and channels of the given length.
+The type of the value cannot be inferred and must be given, e.g. HasLen[[]int](3)
+*/
+func HasLen[T any](length int) Matcher[T] {
+	return &funcMatcher[T]{
+		description: fmt.Sprintf("with length %d", length),
+		match: func(actual T) bool {
+			actualLength, ok := valueLength(actual)
+			return ok && actualLength == length
+		},
+		mismatch: func(actual T) string {
+			actualLength, ok := valueLength(actual)
+			if !ok {
+				return fmt.Sprintf("was %s which has no length", describeValue(actual))
+			}
+			return fmt.Sprintf("had length %d", actualLength)
+		},
+	}
+}
+
+/*
+Matches slices containing at least one element that satisfies the given matcher
+*/
+func ContainsElement[E any](matcher Matcher[E]) Matcher[[]E] {
+	return &funcMatcher[[]E]{
+		description: "containing an element " + matcher.Describe(),
+		match: func(actual []E) bool {
+			return indexOfMatch(actual, matcher) >= 0
+		},
+	}
+}
+
+/*
+Matches maps containing the given key.
+The value type cannot be inferred and must be given, e.g. HasKey[string, int]("id")
+*/
+func HasKey[K comparable, V any](key K) Matcher[map[K]V] {
+	return &funcMatcher[map[K]V]{
+		description: "with key " + describeValue(key),
+		match: func(actual map[K]V) bool {
+			_, found := actual[key]
+			return found
+		},
+	}
+}
+
+/*
+Matches strings matching the given regular expression. Panics if the pattern cannot be compiled
+*/
+func MatchesRegexp(pattern string) Matcher[string] {
+	re := regexp.MustCompile(pattern)
+
+	return &funcMatcher[string]{
+		description: fmt.Sprintf("matching regexp %q", pattern),
+		match:       re.MatchString,
+	}
+}
+
+/*
+Matches values greater than the given value
+*/
+func GreaterThan[T Ordered](lower T) Matcher[T] {
+	return &funcMatcher[T]{
+		description: "greater than " + describeValue(lower),
+		match: func(actual T) bool {
+			return actual > lower
+		},
+	}
+}
+
+/*
+Matches structs, or pointers to structs, whose exported field with the given name satisfies the given matcher.
+The struct type cannot be inferred and must be given, e.g. Field[User]("Name", Eq("Ann"))
+*/
+func Field[T any, F any](name string, matcher Matcher[F]) Matcher[T] {
+	return &funcMatcher[T]{
+		description: fmt.Sprintf("with field %s %s", name, matcher.Describe()),
+		match: func(actual T) bool {
+			value, err := fieldValue[F](actual, name)
+			return err == nil && matcher.Match(value)
+		},
+		mismatch: func(actual T) string {
+			value, err := fieldValue[F](actual, name)
+			if err != nil {
+				return err.Error()
+			}
+			return fmt.Sprintf("had field %s which %s", name, matcher.DescribeMismatch(value))
+		},
+	}
+}
+
+/*
+Implements [Matcher] with functions. The mismatch defaults to printing the actual value
+*/
+type funcMatcher[T any] struct {
+	description string
+	match       func(actual T) bool
+	mismatch    func(actual T) string
+}
+
+func (m *funcMatcher[T]) Match(actual T) bool {
+	return m.match(actual)
+}
+
+func (m *funcMatcher[T]) Describe() string {
+	return m.description
+}
+
+func (m *funcMatcher[T]) DescribeMismatch(actual T) string {
+	if m.mismatch == nil {
+		return "was " + describeValue(actual)
+	}
+
+	return m.mismatch(actual)
+}
+
+func describeValue(value interface{}) string {
+	return prettyPrintValue(reflect.ValueOf(value), true)
+}
+
+func joinDescriptions[T any](matchers []Matcher[T], separator string) string {
+	descriptions := make([]string, len(matchers))
+	for i, matcher := range matchers {
+		descriptions[i] = matcher.Describe()
+	}
+
+	return "(" + strings.Join(descriptions, separator) + ")"
+}
+
+func firstMismatch[T any](matchers []Matcher[T], actual T) Matcher[T] {
+	for _, matcher := range matchers {
+		if !matcher.Match(actual) {
+			return matcher
+		}
+	}
+
+	return nil
+}
+
+func indexOfMatch[T any](s []T, matcher Matcher[T]) int {
+	for i, element := range s {
+		if matcher.Match(element) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func valueLength(value interface{}) (int, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		return v.Len(), true
+	}
+
+	return 0, false
+}
+
+func fieldValue[F any](value interface{}, name string) (F, error) {
+	var zero F
+
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return zero, fmt.Errorf("was nil")
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return zero, fmt.Errorf("was %s which is not a struct", describeValue(value))
+	}
+
+	structField, found := v.Type().FieldByName(name)
+	if !found || !structField.IsExported() {
+		return zero, fmt.Errorf("had no exported field %s", name)
+	}
+
+	f, err := v.FieldByIndexErr(structField.Index)
+	if err != nil {
+		return zero, fmt.Errorf("had no field %s: %v", name, err)
+	}
+
+	// a type assertion would reject a nil field of interface type, so the field is assigned instead
+	if !f.Type().AssignableTo(typeOf[F]()) {
+		return zero, fmt.Errorf("had field %s of type %v", name, structField.Type)
+	}
+
+	var field F
+	reflect.ValueOf(&field).Elem().Set(f)
+
+	return field, nil
+}
@@ -0,0 +1,126 @@
+package goassert
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_ThatShouldPass_GivenSatisfiedMatcher(t *testing.T) {
+	tester := new(testing.T)
+
+	That(tester, 3, Eq(3))
+
+	if tester.Failed() {
+		t.Error("That did not pass when the matcher was satisfied")
+	}
+}
+
+func Test_ThatShouldReportDescriptionAndMismatch_GivenUnsatisfiedMatcher(t *testing.T) {
+	tester := newRecordingT()
+
+	That(tester, 4, Eq(3))
+
+	if tester.output() != "Expected a value equal to 3 but it was 4" {
+		t.Errorf("That did not report the description and mismatch but got %q", tester.output())
+	}
+}
+
+func Test_EqShouldMatch_GivenDeeplyEqualValues(t *testing.T) {
+	if !Eq([]int{1, 2}).Match([]int{1, 2}) {
+		t.Error("Eq did not match deeply equal slices")
+	}
+}
+
+func Test_NotShouldMatch_GivenValueNotSatisfyingMatcher(t *testing.T) {
+	matcher := Not(Eq(3))
+
+	if !matcher.Match(4) || matcher.Match(3) {
+		t.Error("Not did not invert the given matcher")
+	}
+}
+
+func Test_AllOfShouldReportFirstMismatch_GivenUnsatisfiedMatcher(t *testing.T) {
+	tester := newRecordingT()
+
+	That(tester, "goassert", AllOf(MatchesRegexp("^go"), HasLen[string](4)))
+
+	if tester.output() != `Expected a value (matching regexp "^go" and with length 4) but it had length 8` {
+		t.Errorf("AllOf did not report the first mismatch but got %q", tester.output())
+	}
+}
+
+func Test_AnyOfShouldMatch_GivenValueSatisfyingOneMatcher(t *testing.T) {
+	matcher := AnyOf(Eq(1), GreaterThan(10))
+
+	if !matcher.Match(11) || !matcher.Match(1) || matcher.Match(5) {
+		t.Error("AnyOf did not match values satisfying any of the given matchers")
+	}
+}
+
+func Test_HasLenShouldReportNoLength_GivenValueWithoutLength(t *testing.T) {
+	tester := newRecordingT()
+
+	That[interface{}](tester, 3, HasLen[interface{}](1))
+
+	if tester.output() != "Expected a value with length 1 but it was 3 which has no length" {
+		t.Errorf("HasLen did not report a value without length but got %q", tester.output())
+	}
+}
+
+func Test_ContainsElementShouldMatch_GivenSliceWithMatchingElement(t *testing.T) {
+	matcher := ContainsElement(GreaterThan(2))
+
+	if !matcher.Match([]int{1, 3}) || matcher.Match([]int{1, 2}) {
+		t.Error("ContainsElement did not match slices containing a matching element")
+	}
+}
+
+func Test_HasKeyShouldMatch_GivenMapWithKey(t *testing.T) {
+	matcher := HasKey[string, int]("a")
+
+	if !matcher.Match(map[string]int{"a": 1}) || matcher.Match(map[string]int{"b": 1}) {
+		t.Error("HasKey did not match maps containing the key")
+	}
+}
+
+func Test_FieldShouldReportFieldMismatch_GivenStructWithDifferentField(t *testing.T) {
+	tester := newRecordingT()
+
+	That(tester, &mockUser{Name: "Bob"}, Field[*mockUser]("Name", Eq("Ann")))
+
+	if tester.output() != `Expected a value with field Name equal to "Ann" but it had field Name which was "Bob"` {
+		t.Errorf("Field did not report the field mismatch but got %q", tester.output())
+	}
+}
+
+func Test_FieldShouldReportMissingField_GivenStructWithoutField(t *testing.T) {
+	tester := newRecordingT()
+
+	That(tester, mockUser{}, Field[mockUser]("Age", Eq(3)))
+
+	if !strings.Contains(tester.output(), "had no exported field Age") {
+		t.Errorf("Field did not report the missing field but got %q", tester.output())
+	}
+}
+
+func Test_FieldShouldNotMatch_GivenFieldOfDifferentType(t *testing.T) {
+	if Field[mockUser]("Name", Eq(3)).Match(mockUser{Name: "Ann"}) {
+		t.Error("Field matched a field of a different type")
+	}
+}
+
+func Test_FieldShouldMatch_GivenNilFieldOfInterfaceType(t *testing.T) {
+	type result struct {
+		Err error
+	}
+
+	if !Field[result]("Err", Eq[error](nil)).Match(result{}) {
+		t.Error("Field did not match a nil field of interface type")
+	}
+}
+
+func Test_FieldShouldMatch_GivenInterfaceTypeImplementedByField(t *testing.T) {
+	if !Field[mockUser]("Name", Eq[interface{}]("Ann")).Match(mockUser{Name: "Ann"}) {
+		t.Error("Field did not match a field assignable to the interface type")
+	}
+}
@@ -0,0 +1,256 @@
+package goassert
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+/*
+Integer is a constraint matching every integer type
+*/
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+/*
+Float is a constraint matching every floating-point type
+*/
+type Float interface {
+	~float32 | ~float64
+}
+
+/*
+Number is a constraint matching every integer and floating-point type
+*/
+type Number interface {
+	Integer | Float
+}
+
+/*
+Asserts that the difference between the two given numbers is at most the given delta.
+The difference between integers is computed exactly, without converting them to float64.
+NaN is only considered equal to NaN and an infinity is only considered equal to the infinity of the same sign
+*/
+func InDelta[N Number](t testing.TB, expected N, actual N, delta float64) {
+	t.Helper()
+
+	if !numbersWithinDelta(expected, actual, delta) {
+		failf(t, "Expected %v to be within %v of %v but the difference was %v",
+			actual, delta, expected, numberDistance(expected, actual))
+	}
+}
+
+/*
+Asserts that the relative error between the two given numbers, |expected - actual| / |expected|, is at most the given epsilon.
+The assertion fails if expected is zero and actual is not. NaN and infinities follow the same rules as [InDelta]
+*/
+func InEpsilon[N Number](t testing.TB, expected N, actual N, epsilon float64) {
+	t.Helper()
+
+	relativeError, ok := relativeError(float64(expected), float64(actual))
+	if !ok {
+		failf(t, "Expected %v to be within relative error %v of %v but the relative error is undefined", actual, epsilon, expected)
+		return
+	}
+
+	if relativeError > epsilon {
+		failf(t, "Expected relative error between %v and %v to be at most %v but was %v",
+			expected, actual, epsilon, relativeError)
+	}
+}
+
+/*
+Asserts that the two given floats are at most maxULPs units in the last place apart.
+Positive and negative zero are considered equal, NaN is only considered equal to NaN
+*/
+func WithinULPs[F Float](t testing.TB, expected F, actual F, maxULPs uint64) {
+	t.Helper()
+
+	if math.IsNaN(float64(expected)) || math.IsNaN(float64(actual)) {
+		if !math.IsNaN(float64(expected)) || !math.IsNaN(float64(actual)) {
+			fail(t, inequalityMsg(expected, actual))
+		}
+		return
+	}
+
+	distance := ulpDistance(expected, actual)
+	if distance > maxULPs {
+		failf(t, "Expected %v to be within %d ULPs of %v but they were %d ULPs apart", actual, maxULPs, expected, distance)
+	}
+}
+
+/*
+Asserts that the two given floats are exactly equal.
+Unlike [Equal], NaN is considered equal to NaN. Positive and negative zero are considered equal
+*/
+func EqualFloat[F Float](t testing.TB, expected F, actual F) {
+	t.Helper()
+
+	bothNaN := math.IsNaN(float64(expected)) && math.IsNaN(float64(actual))
+	if expected != actual && !bothNaN {
+		fail(t, inequalityMsg(expected, actual))
+	}
+}
+
+/*
+Asserts that the two given slices have the same length and that every element of actual
+is within the given delta of the element at the same index of expected. See [InDelta]
+*/
+func SliceInDelta[N Number](t testing.TB, expected []N, actual []N, delta float64) {
+	t.Helper()
+
+	if len(expected) != len(actual) {
+		failf(t, "Expected slice to have length of %d but got %d", len(expected), len(actual))
+		return
+	}
+
+	for i := range expected {
+		if !numbersWithinDelta(expected[i], actual[i], delta) {
+			failf(t, "Element %v at index %d was expected to be within %v of %v", actual[i], i, delta, expected[i])
+			return
+		}
+	}
+}
+
+/*
+Asserts that the two given maps have the same keys and that every value of actual
+is within the given delta of the value for the same key in expected. See [InDelta]
+*/
+func MapInDelta[K comparable, N Number](t testing.TB, expected map[K]N, actual map[K]N, delta float64) {
+	t.Helper()
+
+	for _, k := range sortedKeys(expected) {
+		actualValue, found := actual[k]
+		if !found {
+			failf(t, "Key %v was not found in the map", k)
+			return
+		}
+
+		if !numbersWithinDelta(expected[k], actualValue, delta) {
+			failf(t, "Value %v for key %v was expected to be within %v of %v", actualValue, k, delta, expected[k])
+			return
+		}
+	}
+
+	for _, k := range sortedKeys(actual) {
+		if _, found := expected[k]; !found {
+			failf(t, "Key %v was not expected to be found in the map", k)
+			return
+		}
+	}
+}
+
+func numbersWithinDelta[N Number](expected N, actual N, delta float64) bool {
+	distance, isInteger := integerDistance(expected, actual)
+	if !isInteger {
+		return withinDelta(float64(expected), float64(actual), delta)
+	}
+
+	if math.IsNaN(delta) || delta < 0 {
+		return false
+	}
+	if delta >= math.MaxUint64 {
+		return true
+	}
+
+	// the distance is a whole number, so comparing it to the truncated delta is exact
+	return distance <= uint64(delta)
+}
+
+func numberDistance[N Number](expected N, actual N) interface{} {
+	if distance, isInteger := integerDistance(expected, actual); isInteger {
+		return distance
+	}
+
+	return math.Abs(float64(expected) - float64(actual))
+}
+
+/*
+Returns the exact distance between two integers. The subtraction is done on uint64, where it wraps around
+to the right result even when the difference does not fit in the signed type
+*/
+func integerDistance[N Number](expected N, actual N) (uint64, bool) {
+	e, a := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	switch e.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		x, y := e.Int(), a.Int()
+		if x > y {
+			return uint64(x) - uint64(y), true
+		}
+		return uint64(y) - uint64(x), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		x, y := e.Uint(), a.Uint()
+		if x > y {
+			return x - y, true
+		}
+		return y - x, true
+	}
+
+	return 0, false
+}
+
+func withinDelta(expected float64, actual float64, delta float64) bool {
+	if math.IsNaN(expected) || math.IsNaN(actual) {
+		return math.IsNaN(expected) && math.IsNaN(actual)
+	}
+
+	if math.IsInf(expected, 0) || math.IsInf(actual, 0) {
+		return expected == actual
+	}
+
+	return math.Abs(expected-actual) <= delta
+}
+
+func relativeError(expected float64, actual float64) (float64, bool) {
+	if math.IsNaN(expected) || math.IsNaN(actual) || math.IsInf(expected, 0) || math.IsInf(actual, 0) {
+		if withinDelta(expected, actual, 0) {
+			return 0, true
+		}
+		return 0, false
+	}
+
+	if expected == 0 {
+		if actual == 0 {
+			return 0, true
+		}
+		return 0, false
+	}
+
+	return math.Abs(expected-actual) / math.Abs(expected), true
+}
+
+func ulpDistance[F Float](expected F, actual F) uint64 {
+	var a, b int64
+	if reflect.ValueOf(expected).Kind() == reflect.Float32 {
+		a = int64(orderedFloat32Bits(float32(expected)))
+		b = int64(orderedFloat32Bits(float32(actual)))
+	} else {
+		a = orderedFloat64Bits(float64(expected))
+		b = orderedFloat64Bits(float64(actual))
+	}
+
+	if a > b {
+		return uint64(a) - uint64(b)
+	}
+	return uint64(b) - uint64(a)
+}
+
+// Maps the bits of a float onto integers that are ordered the same way as the floats,
+// so that adjacent representable floats map to adjacent integers
+func orderedFloat64Bits(f float64) int64 {
+	bits := math.Float64bits(f)
+	if bits>>63 == 1 {
+		return -int64(bits &^ (1 << 63))
+	}
+	return int64(bits)
+}
+
+func orderedFloat32Bits(f float32) int32 {
+	bits := math.Float32bits(f)
+	if bits>>31 == 1 {
+		return -int32(bits &^ (1 << 31))
+	}
+	return int32(bits)
+}
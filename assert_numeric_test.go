@@ -0,0 +1,260 @@
+package goassert
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func Test_InDeltaShouldPass_WhenDifferenceIsWithinDelta(t *testing.T) {
+	tester := new(testing.T)
+
+	a, b := 0.1, 0.2
+	InDelta(tester, 0.3, a+b, 1e-9)
+
+	if tester.Failed() {
+		t.Error("InDelta did not pass when the difference was within delta")
+	}
+}
+
+func Test_InDeltaShouldPass_GivenIntegersWithinDelta(t *testing.T) {
+	tester := new(testing.T)
+
+	InDelta(tester, 10, 12, 2)
+
+	if tester.Failed() {
+		t.Error("InDelta did not pass when given integers within delta")
+	}
+}
+
+func Test_InDeltaShouldFail_WhenDifferenceExceedsDelta(t *testing.T) {
+	tester := new(testing.T)
+
+	InDelta(tester, 1.0, 1.1, 0.05)
+
+	if !tester.Failed() {
+		t.Error("InDelta did not fail when the difference exceeded delta")
+	}
+}
+
+func Test_InDeltaShouldFail_GivenLargeIntegersBeyondFloatPrecision(t *testing.T) {
+	tester := newRecordingT()
+
+	InDelta(tester, int64(1<<53), int64(1<<53+1), 0)
+
+	if !strings.Contains(tester.output(), "the difference was 1") {
+		t.Errorf("InDelta did not fail with the exact difference of large integers but got %q", tester.output())
+	}
+}
+
+func Test_InDeltaShouldPass_GivenIntegersAtOppositeEndsOfRange(t *testing.T) {
+	tester := new(testing.T)
+
+	InDelta(tester, int64(math.MinInt64), int64(math.MaxInt64), math.MaxUint64)
+	InDelta(tester, uint64(0), uint64(math.MaxUint64), math.MaxUint64)
+
+	if tester.Failed() {
+		t.Error("InDelta did not pass when the difference between integers fit in the delta")
+	}
+}
+
+func Test_SliceInDeltaShouldFail_GivenLargeIntegersBeyondFloatPrecision(t *testing.T) {
+	tester := new(testing.T)
+
+	SliceInDelta(tester, []uint64{1<<63 + 1}, []uint64{1 << 63}, 0.5)
+
+	if !tester.Failed() {
+		t.Error("SliceInDelta did not fail when large integers differed by more than delta")
+	}
+}
+
+func Test_InDeltaShouldPass_GivenNaNs(t *testing.T) {
+	tester := new(testing.T)
+
+	InDelta(tester, math.NaN(), math.NaN(), 0)
+
+	if tester.Failed() {
+		t.Error("InDelta did not pass when given two NaNs")
+	}
+}
+
+func Test_InDeltaShouldFail_GivenNaNAndNumber(t *testing.T) {
+	tester := new(testing.T)
+
+	InDelta(tester, 1.0, math.NaN(), math.Inf(1))
+
+	if !tester.Failed() {
+		t.Error("InDelta did not fail when given NaN and a number")
+	}
+}
+
+func Test_InDeltaShouldFail_GivenInfinitiesOfDifferentSign(t *testing.T) {
+	tester := new(testing.T)
+
+	InDelta(tester, math.Inf(1), math.Inf(-1), math.Inf(1))
+
+	if !tester.Failed() {
+		t.Error("InDelta did not fail when given infinities of different sign")
+	}
+}
+
+func Test_InEpsilonShouldPass_WhenRelativeErrorIsWithinEpsilon(t *testing.T) {
+	tester := new(testing.T)
+
+	InEpsilon(tester, 1000.0, 1009.0, 0.01)
+
+	if tester.Failed() {
+		t.Error("InEpsilon did not pass when the relative error was within epsilon")
+	}
+}
+
+func Test_InEpsilonShouldFail_WhenRelativeErrorExceedsEpsilon(t *testing.T) {
+	tester := new(testing.T)
+
+	InEpsilon(tester, 10.0, 11.0, 0.01)
+
+	if !tester.Failed() {
+		t.Error("InEpsilon did not fail when the relative error exceeded epsilon")
+	}
+}
+
+func Test_InEpsilonShouldFail_WhenExpectedIsZeroAndActualIsNot(t *testing.T) {
+	tester := new(testing.T)
+
+	InEpsilon(tester, 0.0, 1e-12, 0.5)
+
+	if !tester.Failed() {
+		t.Error("InEpsilon did not fail when expected was zero and actual was not")
+	}
+}
+
+func Test_WithinULPsShouldPass_GivenAdjacentFloats(t *testing.T) {
+	tester := new(testing.T)
+
+	WithinULPs(tester, 1.0, math.Nextafter(1.0, 2.0), 1)
+
+	if tester.Failed() {
+		t.Error("WithinULPs did not pass when given adjacent floats")
+	}
+}
+
+func Test_WithinULPsShouldPass_GivenPositiveAndNegativeZero(t *testing.T) {
+	tester := new(testing.T)
+
+	WithinULPs(tester, 0.0, math.Copysign(0, -1), 0)
+
+	if tester.Failed() {
+		t.Error("WithinULPs did not pass when given positive and negative zero")
+	}
+}
+
+func Test_WithinULPsShouldFail_WhenFloatsAreTooFarApart(t *testing.T) {
+	tester := new(testing.T)
+
+	WithinULPs(tester, float32(1.0), float32(1.001), 4)
+
+	if !tester.Failed() {
+		t.Error("WithinULPs did not fail when the floats were too far apart")
+	}
+}
+
+func Test_WithinULPsShouldFail_GivenNaNAndNumber(t *testing.T) {
+	tester := new(testing.T)
+
+	WithinULPs(tester, math.NaN(), 1.0, math.MaxUint64)
+
+	if !tester.Failed() {
+		t.Error("WithinULPs did not fail when given NaN and a number")
+	}
+}
+
+func Test_EqualFloatShouldPass_GivenNaNs(t *testing.T) {
+	tester := new(testing.T)
+
+	EqualFloat(tester, math.NaN(), math.NaN())
+
+	if tester.Failed() {
+		t.Error("EqualFloat did not pass when given two NaNs")
+	}
+}
+
+func Test_EqualFloatShouldFail_GivenDifferentFloats(t *testing.T) {
+	tester := new(testing.T)
+
+	a, b := 0.1, 0.2
+	EqualFloat(tester, 0.3, a+b)
+
+	if !tester.Failed() {
+		t.Error("EqualFloat did not fail when given different floats")
+	}
+}
+
+func Test_SliceInDeltaShouldPass_WhenAllElementsAreWithinDelta(t *testing.T) {
+	tester := new(testing.T)
+
+	SliceInDelta(tester, []float64{1, 2, 3}, []float64{1.01, 1.99, 3}, 0.05)
+
+	if tester.Failed() {
+		t.Error("SliceInDelta did not pass when all elements were within delta")
+	}
+}
+
+func Test_SliceInDeltaShouldReportFirstIndex_WhenElementExceedsDelta(t *testing.T) {
+	tester := newRecordingT()
+
+	SliceInDelta(tester, []float64{1, 2, 3, 4}, []float64{1, 2.5, 3.5, 4}, 0.1)
+
+	if !tester.Failed() || !strings.Contains(tester.output(), "index 1") {
+		t.Errorf("SliceInDelta did not report the first index out of tolerance but got %q", tester.output())
+	}
+}
+
+func Test_SliceInDeltaShouldFail_GivenSlicesOfDifferentLengths(t *testing.T) {
+	tester := new(testing.T)
+
+	SliceInDelta(tester, []float64{1, 2}, []float64{1}, 0.1)
+
+	if !tester.Failed() {
+		t.Error("SliceInDelta did not fail when given slices of different lengths")
+	}
+}
+
+func Test_MapInDeltaShouldPass_WhenAllValuesAreWithinDelta(t *testing.T) {
+	tester := new(testing.T)
+
+	MapInDelta(tester, map[string]float64{"a": 1, "b": 2}, map[string]float64{"a": 1.01, "b": 1.99}, 0.05)
+
+	if tester.Failed() {
+		t.Error("MapInDelta did not pass when all values were within delta")
+	}
+}
+
+func Test_MapInDeltaShouldReportKey_WhenValueExceedsDelta(t *testing.T) {
+	tester := newRecordingT()
+
+	MapInDelta(tester, map[string]float64{"a": 1, "b": 2}, map[string]float64{"a": 1, "b": 2.5}, 0.1)
+
+	if !tester.Failed() || !strings.Contains(tester.output(), "key b") {
+		t.Errorf("MapInDelta did not report the key out of tolerance but got %q", tester.output())
+	}
+}
+
+func Test_MapInDeltaShouldFail_WhenActualHasUnexpectedKey(t *testing.T) {
+	tester := new(testing.T)
+
+	MapInDelta(tester, map[string]float64{"a": 1}, map[string]float64{"a": 1, "b": 2}, 0.1)
+
+	if !tester.Failed() {
+		t.Error("MapInDelta did not fail when actual had an unexpected key")
+	}
+}
+
+func Test_MapInDeltaShouldReportUnexpectedNilKey_GivenNilInterfaceKey(t *testing.T) {
+	tester := newRecordingT()
+
+	MapInDelta(tester, map[interface{}]float64{"a": 1}, map[interface{}]float64{"a": 1, nil: 2}, 0.1)
+
+	if tester.output() != "Key <nil> was not expected to be found in the map" {
+		t.Errorf("MapInDelta did not report the unexpected nil key but got %q", tester.output())
+	}
+}
@@ -0,0 +1,87 @@
+package goassert
+
+import "testing"
+
+/*
+Ordered is a constraint matching every type that supports the <, <=, >= and > operators
+*/
+type Ordered interface {
+	Integer | Float | ~string
+}
+
+/*
+Asserts that the first given value is greater than the second given value
+*/
+func Greater[T Ordered](t testing.TB, a T, b T) {
+	t.Helper()
+
+	if !(a > b) {
+		failf(t, "Expected %v to be greater than %v", a, b)
+	}
+}
+
+/*
+Asserts that the first given value is greater than or equal to the second given value
+*/
+func GreaterOrEqual[T Ordered](t testing.TB, a T, b T) {
+	t.Helper()
+
+	if !(a >= b) {
+		failf(t, "Expected %v to be greater than or equal to %v", a, b)
+	}
+}
+
+/*
+Asserts that the first given value is less than the second given value
+*/
+func Less[T Ordered](t testing.TB, a T, b T) {
+	t.Helper()
+
+	if !(a < b) {
+		failf(t, "Expected %v to be less than %v", a, b)
+	}
+}
+
+/*
+Asserts that the first given value is less than or equal to the second given value
+*/
+func LessOrEqual[T Ordered](t testing.TB, a T, b T) {
+	t.Helper()
+
+	if !(a <= b) {
+		failf(t, "Expected %v to be less than or equal to %v", a, b)
+	}
+}
+
+/*
+Asserts that the given value is within the given inclusive bounds
+*/
+func Between[T Ordered](t testing.TB, actual T, lower T, upper T) {
+	t.Helper()
+
+	if !(actual >= lower && actual <= upper) {
+		failf(t, "Expected %v to be between %v and %v", actual, lower, upper)
+	}
+}
+
+/*
+Asserts that the given number is greater than zero
+*/
+func Positive[N Number](t testing.TB, actual N) {
+	t.Helper()
+
+	if !(actual > 0) {
+		failf(t, "Expected %v to be positive", actual)
+	}
+}
+
+/*
+Asserts that the given number is less than zero
+*/
+func Negative[N Number](t testing.TB, actual N) {
+	t.Helper()
+
+	if !(actual < 0) {
+		failf(t, "Expected %v to be negative", actual)
+	}
+}
@@ -0,0 +1,186 @@
+package goassert
+
+import (
+	"math"
+	"testing"
+)
+
+func Test_GreaterShouldPass_WhenFirstValueIsGreater(t *testing.T) {
+	tester := new(testing.T)
+
+	Greater(tester, 16, 10)
+
+	if tester.Failed() {
+		t.Error("Greater did not pass when the first value was greater")
+	}
+}
+
+func Test_GreaterShouldFail_WhenValuesAreEqual(t *testing.T) {
+	tester := new(testing.T)
+
+	Greater(tester, 10, 10)
+
+	if !tester.Failed() {
+		t.Error("Greater did not fail when the values were equal")
+	}
+}
+
+func Test_GreaterShouldReportBothOperands_WhenFirstValueIsLess(t *testing.T) {
+	tester := newRecordingT()
+
+	Greater(tester, "apple", "banana")
+
+	if tester.output() != "Expected apple to be greater than banana" {
+		t.Errorf("Greater did not report both operands and the relation but got %q", tester.output())
+	}
+}
+
+func Test_GreaterOrEqualShouldPass_WhenValuesAreEqual(t *testing.T) {
+	tester := new(testing.T)
+
+	GreaterOrEqual(tester, 10.5, 10.5)
+
+	if tester.Failed() {
+		t.Error("GreaterOrEqual did not pass when the values were equal")
+	}
+}
+
+func Test_GreaterOrEqualShouldFail_WhenFirstValueIsLess(t *testing.T) {
+	tester := new(testing.T)
+
+	GreaterOrEqual(tester, 5, 10)
+
+	if !tester.Failed() {
+		t.Error("GreaterOrEqual did not fail when the first value was less")
+	}
+}
+
+func Test_LessShouldPass_WhenFirstValueIsLess(t *testing.T) {
+	tester := new(testing.T)
+
+	Less(tester, uint8(5), uint8(10))
+
+	if tester.Failed() {
+		t.Error("Less did not pass when the first value was less")
+	}
+}
+
+func Test_LessShouldFail_WhenFirstValueIsGreater(t *testing.T) {
+	tester := new(testing.T)
+
+	Less(tester, 16, 10)
+
+	if !tester.Failed() {
+		t.Error("Less did not fail when the first value was greater")
+	}
+}
+
+func Test_LessShouldFail_GivenNaN(t *testing.T) {
+	tester := new(testing.T)
+
+	Less(tester, math.NaN(), 10)
+
+	if !tester.Failed() {
+		t.Error("Less did not fail when given NaN")
+	}
+}
+
+func Test_LessOrEqualShouldPass_WhenValuesAreEqual(t *testing.T) {
+	tester := new(testing.T)
+
+	LessOrEqual(tester, "apple", "apple")
+
+	if tester.Failed() {
+		t.Error("LessOrEqual did not pass when the values were equal")
+	}
+}
+
+func Test_LessOrEqualShouldFail_WhenFirstValueIsGreater(t *testing.T) {
+	tester := new(testing.T)
+
+	LessOrEqual(tester, 16, 10)
+
+	if !tester.Failed() {
+		t.Error("LessOrEqual did not fail when the first value was greater")
+	}
+}
+
+func Test_BetweenShouldPass_WhenValueIsOnBound(t *testing.T) {
+	tester := new(testing.T)
+
+	Between(tester, 10, 5, 10)
+
+	if tester.Failed() {
+		t.Error("Between did not pass when the value was on the bound")
+	}
+}
+
+func Test_BetweenShouldFail_WhenValueIsOutOfBounds(t *testing.T) {
+	tester := new(testing.T)
+
+	Between(tester, 16, 5, 10)
+
+	if !tester.Failed() {
+		t.Error("Between did not fail when the value was out of bounds")
+	}
+}
+
+func Test_PositiveShouldPass_GivenPositiveNumber(t *testing.T) {
+	tester := new(testing.T)
+
+	Positive(tester, 0.5)
+
+	if tester.Failed() {
+		t.Error("Positive did not pass when given positive number")
+	}
+}
+
+func Test_PositiveShouldFail_GivenZero(t *testing.T) {
+	tester := new(testing.T)
+
+	Positive(tester, 0)
+
+	if !tester.Failed() {
+		t.Error("Positive did not fail when given zero")
+	}
+}
+
+func Test_NegativeShouldPass_GivenNegativeNumber(t *testing.T) {
+	tester := new(testing.T)
+
+	Negative(tester, -3)
+
+	if tester.Failed() {
+		t.Error("Negative did not pass when given negative number")
+	}
+}
+
+func Test_NegativeShouldFail_GivenPositiveNumber(t *testing.T) {
+	tester := new(testing.T)
+
+	Negative(tester, 3)
+
+	if !tester.Failed() {
+		t.Error("Negative did not fail when given positive number")
+	}
+}
+
+func Test_ZeroShouldPass_GivenZero(t *testing.T) {
+	tester := new(testing.T)
+
+	Zero(tester, 0)
+
+	if tester.Failed() {
+		t.Error("Zero did not pass when given zero")
+	}
+}
+
+func Test_ZeroShouldFail_GivenNonZeroNumber(t *testing.T) {
+	tester := new(testing.T)
+
+	Zero(tester, 0.1)
+
+	if !tester.Failed() {
+		t.Error("Zero did not fail when given non-zero number")
+	}
+}
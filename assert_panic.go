@@ -1,7 +1,11 @@
 package goassert
 
 import (
+	"errors"
+	"fmt"
 	"reflect"
+	"runtime/debug"
+	"strings"
 	"testing"
 )
 
@@ -11,28 +15,20 @@ Asserts that the given function panics
 func Panic(t testing.TB, underTest func()) {
 	t.Helper()
 
-	defer func() {
-		if r := recover(); r == nil {
-			t.Error("Expected panic but there was no panic")
-		}
-	}()
-
-	underTest()
+	if !capturePanic(underTest).panicked() {
+		fail(t, "Expected panic but there was no panic")
+	}
 }
 
 /*
-Asserts that the given function does not panic
+Asserts that the given function does not panic. The stack of the panic is printed on failure
 */
 func NotPanic(t testing.TB, underTest func()) {
 	t.Helper()
 
-	defer func() {
-		if r := recover(); r != nil {
-			t.Errorf("Expected no panic but there was panic: %v", r)
-		}
-	}()
-
-	underTest()
+	if p := capturePanic(underTest); p.panicked() {
+		fail(t, p.msg(fmt.Sprintf("Expected no panic but there was panic: %v", p.recovered)))
+	}
 }
 
 /*
@@ -42,19 +38,15 @@ The actual error must be of the same type and value as the given error
 func PanicWithError[T any](t testing.TB, expectedError T, underTest func()) {
 	t.Helper()
 
-	defer func() {
-		r := recover()
-		if r == nil {
-			t.Error("Expected panic but there was no panic")
-			return
-		}
-
-		if !reflect.DeepEqual(expectedError, r) {
-			t.Errorf("Expected panic with %v error but got %v error", expectedError, r)
-		}
-	}()
+	p := capturePanic(underTest)
+	if !p.panicked() {
+		fail(t, "Expected panic but there was no panic")
+		return
+	}
 
-	underTest()
+	if !reflect.DeepEqual(expectedError, p.recovered) {
+		fail(t, p.msg(fmt.Sprintf("Expected panic with %v error but got %v error", expectedError, p.recovered)))
+	}
 }
 
 /*
@@ -64,16 +56,121 @@ The assertion succeeds if the given function does not panic or panics with a dif
 func NotPanicWithError[T any](t testing.TB, expectedError T, underTest func()) {
 	t.Helper()
 
-	defer func() {
-		r := recover()
-		if r == nil {
-			return
-		}
+	p := capturePanic(underTest)
+	if p.panicked() && reflect.DeepEqual(expectedError, p.recovered) {
+		failf(t, "Expected panic with different error than %v error", expectedError)
+	}
+}
+
+/*
+Asserts that the given function panics with a message containing the given substring.
+The message of a recovered error is its Error() and the message of any other value is its fmt.Sprint representation
+*/
+func PanicsWithMessage(t testing.TB, substring string, underTest func()) {
+	t.Helper()
+
+	p := capturePanic(underTest)
+	if !p.panicked() {
+		fail(t, "Expected panic but there was no panic")
+		return
+	}
+
+	if message := panicMessage(p.recovered); !strings.Contains(message, substring) {
+		fail(t, p.msg(fmt.Sprintf("Expected panic with a message containing %q but got %q", substring, message)))
+	}
+}
+
+/*
+Asserts that the given function panics with an error matching the given target. Internally uses errors.Is
+*/
+func PanicsWithErrorIs(t testing.TB, target error, underTest func()) {
+	t.Helper()
+
+	p := capturePanic(underTest)
+	if !p.panicked() {
+		fail(t, "Expected panic but there was no panic")
+		return
+	}
+
+	err, isError := p.recovered.(error)
+	if !isError {
+		fail(t, p.msg(fmt.Sprintf("Expected panic with an error matching %v but got %T: %v", target, p.recovered, p.recovered)))
+		return
+	}
 
-		if reflect.DeepEqual(expectedError, r) {
-			t.Errorf("Expected panic with different error than %v error", expectedError)
+	if !errors.Is(err, target) {
+		fail(t, p.msg(fmt.Sprintf("Expected panic with an error chain containing %v but it did not\n%s", target, errorChainMsg(err))))
+	}
+}
+
+/*
+Asserts that the given function panics with a value for which the given function returns true
+*/
+func PanicsMatching(t testing.TB, matches func(recovered interface{}) bool, underTest func()) {
+	t.Helper()
+
+	p := capturePanic(underTest)
+	if !p.panicked() {
+		fail(t, "Expected panic but there was no panic")
+		return
+	}
+
+	if !matches(p.recovered) {
+		fail(t, p.msg(fmt.Sprintf("Expected panic with a matching value but got %T: %v", p.recovered, p.recovered)))
+	}
+}
+
+/*
+Asserts that the given function panics and returns the recovered value for further assertions.
+Returns nil if the function does not panic
+*/
+func RecoverPanic(t testing.TB, underTest func()) interface{} {
+	t.Helper()
+
+	p := capturePanic(underTest)
+	if !p.panicked() {
+		fail(t, "Expected panic but there was no panic")
+	}
+
+	return p.recovered
+}
+
+/*
+A panic recovered from a function, with the stack captured at the panic site
+*/
+type recoveredPanic struct {
+	recovered interface{}
+	stack     []byte
+}
+
+func (p recoveredPanic) panicked() bool {
+	return p.recovered != nil
+}
+
+func (p recoveredPanic) msg(header string) string {
+	return fmt.Sprintf("%s\n\nPanic stack:\n%s", header, strings.TrimSpace(string(p.stack)))
+}
+
+/*
+Calls the given function and recovers its panic, if any. The stack is captured in the deferred function,
+which runs on top of the panicking frames, so it shows where the panic happened
+*/
+func capturePanic(underTest func()) (p recoveredPanic) {
+	defer func() {
+		if r := recover(); r != nil {
+			p = recoveredPanic{recovered: r, stack: debug.Stack()}
 		}
 	}()
 
 	underTest()
+
+	return recoveredPanic{}
+}
+
+func panicMessage(recovered interface{}) string {
+	if err, ok := recovered.(error); ok {
+		return err.Error()
+	}
+
+	return fmt.Sprint(recovered)
 }
@@ -1,6 +1,11 @@
 package goassert
 
-import "testing"
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
 
 func Test_PanicShouldPass_WhenGivenFuncPanics(t *testing.T) {
 	tester := new(testing.T)
@@ -119,3 +124,208 @@ func Test_NotPanicWithErrorShouldFail_WhenGivenFuncPanicsWithGivenError(t *testi
 		t.Error("NotPanicWithError did not fail when the given func panicked with given error")
 	}
 }
+
+func Test_NotPanicShouldReportPanicStack_WhenGivenFuncPanics(t *testing.T) {
+	tester := newRecordingT()
+
+	NotPanic(tester, panickingFunc)
+
+	for _, expected := range []string{"Expected no panic but there was panic: panicking func", "Panic stack:", "goassert.panickingFunc"} {
+		if !strings.Contains(tester.output(), expected) {
+			t.Errorf("NotPanic did not report %q but got:\n%s", expected, tester.output())
+		}
+	}
+}
+
+func Test_PanicWithErrorShouldReportPanicStack_WhenGivenFuncPanicsWithDifferentError(t *testing.T) {
+	tester := newRecordingT()
+
+	PanicWithError(tester, "Error", panickingFunc)
+
+	if !strings.Contains(tester.output(), "goassert.panickingFunc") {
+		t.Errorf("PanicWithError did not report the panic stack but got:\n%s", tester.output())
+	}
+}
+
+func Test_PanicsWithMessageShouldPass_WhenGivenFuncPanicsWithMessageContainingSubstring(t *testing.T) {
+	tester := new(testing.T)
+
+	PanicsWithMessage(tester, "index out of range", func() {
+		panic("runtime error: index out of range [3] with length 3")
+	})
+
+	if tester.Failed() {
+		t.Error("PanicsWithMessage did not pass when the given func panicked with a message containing the substring")
+	}
+}
+
+func Test_PanicsWithMessageShouldPass_WhenGivenFuncPanicsWithErrorContainingSubstring(t *testing.T) {
+	tester := new(testing.T)
+
+	PanicsWithMessage(tester, "connection refused", func() {
+		panic(fmt.Errorf("dialing: %w", errors.New("connection refused")))
+	})
+
+	if tester.Failed() {
+		t.Error("PanicsWithMessage did not pass when the given func panicked with an error containing the substring")
+	}
+}
+
+func Test_PanicsWithMessageShouldPass_WhenGivenFuncPanicsWithNonStringValue(t *testing.T) {
+	tester := new(testing.T)
+
+	PanicsWithMessage(tester, "42", func() {
+		panic(42)
+	})
+
+	if tester.Failed() {
+		t.Error("PanicsWithMessage did not pass when the given func panicked with a value printing the substring")
+	}
+}
+
+func Test_PanicsWithMessageShouldFail_WhenGivenFuncPanicsWithDifferentMessage(t *testing.T) {
+	tester := newRecordingT()
+
+	PanicsWithMessage(tester, "timeout", panickingFunc)
+
+	if !tester.Failed() {
+		t.Error("PanicsWithMessage did not fail when the given func panicked with a different message")
+	}
+
+	for _, expected := range []string{`Expected panic with a message containing "timeout" but got "panicking func"`, "goassert.panickingFunc"} {
+		if !strings.Contains(tester.output(), expected) {
+			t.Errorf("PanicsWithMessage did not report %q but got:\n%s", expected, tester.output())
+		}
+	}
+}
+
+func Test_PanicsWithMessageShouldFail_WhenGivenFuncDoesNotPanic(t *testing.T) {
+	tester := new(testing.T)
+
+	PanicsWithMessage(tester, "Error", func() {})
+
+	if !tester.Failed() {
+		t.Error("PanicsWithMessage did not fail when the given func did not panic")
+	}
+}
+
+func Test_PanicsWithErrorIsShouldPass_WhenGivenFuncPanicsWithWrappedTarget(t *testing.T) {
+	tester := new(testing.T)
+
+	target := errors.New("not found")
+	PanicsWithErrorIs(tester, target, func() {
+		panic(fmt.Errorf("loading user: %w", target))
+	})
+
+	if tester.Failed() {
+		t.Error("PanicsWithErrorIs did not pass when the given func panicked with an error wrapping the target")
+	}
+}
+
+func Test_PanicsWithErrorIsShouldFail_WhenGivenFuncPanicsWithDifferentError(t *testing.T) {
+	tester := newRecordingT()
+
+	PanicsWithErrorIs(tester, errors.New("not found"), func() {
+		panic(fmt.Errorf("loading user: %w", errors.New("permission denied")))
+	})
+
+	if !tester.Failed() {
+		t.Error("PanicsWithErrorIs did not fail when the given func panicked with a different error")
+	}
+
+	for _, expected := range []string{"Error chain:", "\t\t*errors.errorString: \"permission denied\"", "Panic stack:"} {
+		if !strings.Contains(tester.output(), expected) {
+			t.Errorf("PanicsWithErrorIs did not report %q but got:\n%s", expected, tester.output())
+		}
+	}
+}
+
+func Test_PanicsWithErrorIsShouldFail_WhenGivenFuncPanicsWithNonError(t *testing.T) {
+	tester := newRecordingT()
+
+	PanicsWithErrorIs(tester, errors.New("not found"), panickingFunc)
+
+	if !strings.Contains(tester.output(), "but got string: panicking func") {
+		t.Errorf("PanicsWithErrorIs did not report the recovered non-error value but got:\n%s", tester.output())
+	}
+}
+
+func Test_PanicsWithErrorIsShouldFail_WhenGivenFuncDoesNotPanic(t *testing.T) {
+	tester := new(testing.T)
+
+	PanicsWithErrorIs(tester, errors.New("not found"), func() {})
+
+	if !tester.Failed() {
+		t.Error("PanicsWithErrorIs did not fail when the given func did not panic")
+	}
+}
+
+func Test_PanicsMatchingShouldPass_WhenRecoveredValueMatches(t *testing.T) {
+	tester := new(testing.T)
+
+	PanicsMatching(tester, func(recovered interface{}) bool {
+		code, isInt := recovered.(int)
+		return isInt && code >= 500
+	}, func() {
+		panic(503)
+	})
+
+	if tester.Failed() {
+		t.Error("PanicsMatching did not pass when the recovered value matched")
+	}
+}
+
+func Test_PanicsMatchingShouldFail_WhenRecoveredValueDoesNotMatch(t *testing.T) {
+	tester := newRecordingT()
+
+	PanicsMatching(tester, func(recovered interface{}) bool {
+		_, isInt := recovered.(int)
+		return isInt
+	}, panickingFunc)
+
+	if !strings.Contains(tester.output(), "Expected panic with a matching value but got string: panicking func") {
+		t.Errorf("PanicsMatching did not report the recovered value but got:\n%s", tester.output())
+	}
+}
+
+func Test_PanicsMatchingShouldFail_WhenGivenFuncDoesNotPanic(t *testing.T) {
+	tester := new(testing.T)
+
+	PanicsMatching(tester, func(interface{}) bool { return true }, func() {})
+
+	if !tester.Failed() {
+		t.Error("PanicsMatching did not fail when the given func did not panic")
+	}
+}
+
+func Test_RecoverPanicShouldReturnRecoveredValue_WhenGivenFuncPanics(t *testing.T) {
+	tester := new(testing.T)
+
+	recovered := RecoverPanic(tester, panickingFunc)
+
+	if tester.Failed() {
+		t.Error("RecoverPanic did not pass when the given func panicked")
+	}
+
+	if recovered != "panicking func" {
+		t.Errorf("RecoverPanic did not return the recovered value but got %v", recovered)
+	}
+}
+
+func Test_RecoverPanicShouldFailAndReturnNil_WhenGivenFuncDoesNotPanic(t *testing.T) {
+	tester := new(testing.T)
+
+	recovered := RecoverPanic(tester, func() {})
+
+	if !tester.Failed() {
+		t.Error("RecoverPanic did not fail when the given func did not panic")
+	}
+
+	if recovered != nil {
+		t.Errorf("RecoverPanic did not return nil when the given func did not panic but got %v", recovered)
+	}
+}
+
+func panickingFunc() {
+	panic("panicking func")
+}
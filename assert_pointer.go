@@ -0,0 +1,57 @@
+package goassert
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+/*
+Asserts that the two given pointers point to the same value, i.e. hold the same address.
+Unlike [DeepEqual], two pointers to distinct but equal values are not the same
+*/
+func Same[T any](t testing.TB, expected *T, actual *T) {
+	t.Helper()
+
+	if expected != actual {
+		failf(t, "Expected pointers to the same value but got %s and %s", describePointer(expected), describePointer(actual))
+	}
+}
+
+/*
+Asserts that the two given pointers do not point to the same value, i.e. hold different addresses
+*/
+func NotSame[T any](t testing.TB, expected *T, actual *T) {
+	t.Helper()
+
+	if expected == actual {
+		failf(t, "Expected pointers to different values but both are %s", describePointer(actual))
+	}
+}
+
+/*
+Asserts that the given pointer is not nil and that the value it points to is deeply equal to the expected value
+*/
+func PointeeEqual[T any](t testing.TB, expected T, ptr *T) {
+	t.Helper()
+
+	if ptr == nil {
+		failf(t, "Expected a pointer to %s but got nil", describeValue(expected))
+		return
+	}
+
+	if !reflect.DeepEqual(expected, *ptr) {
+		failf(t, "Unexpected value pointed to by %p. %s", ptr, inequalityMsg(expected, *ptr))
+	}
+}
+
+/*
+Describes the given pointer with its address and the value it points to, e.g. 0xc000012345 -> 42
+*/
+func describePointer[T any](ptr *T) string {
+	if ptr == nil {
+		return "nil"
+	}
+
+	return fmt.Sprintf("%p -> %s", ptr, prettyPrintValue(reflect.ValueOf(ptr).Elem(), true))
+}
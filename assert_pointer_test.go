@@ -0,0 +1,90 @@
+package goassert
+
+import (
+	"regexp"
+	"testing"
+)
+
+func Test_SameShouldPass_GivenPointersToSameValue(t *testing.T) {
+	tester := new(testing.T)
+	user := &mockUser{Name: "Ann"}
+
+	Same(tester, user, user)
+
+	if tester.Failed() {
+		t.Error("Same did not pass when the pointers pointed to the same value")
+	}
+}
+
+func Test_SameShouldReportAddressesAndValues_GivenPointersToEqualValues(t *testing.T) {
+	tester := newRecordingT()
+
+	Same(tester, &mockStruct{Prop: 1}, &mockStruct{Prop: 1})
+
+	pattern := `^Expected pointers to the same value but got 0x[0-9a-f]+ -> goassert\.mockStruct\{Prop: 1\} and 0x[0-9a-f]+ -> goassert\.mockStruct\{Prop: 1\}$`
+	if !regexp.MustCompile(pattern).MatchString(tester.output()) {
+		t.Errorf("Same did not report the addresses and values but got %q", tester.output())
+	}
+}
+
+func Test_SameShouldFail_GivenNilAndNonNilPointers(t *testing.T) {
+	tester := new(testing.T)
+
+	Same(tester, nil, &mockStruct{})
+
+	if !tester.Failed() {
+		t.Error("Same did not fail when one of the pointers was nil")
+	}
+}
+
+func Test_NotSameShouldPass_GivenPointersToEqualValues(t *testing.T) {
+	tester := new(testing.T)
+
+	NotSame(tester, &mockStruct{Prop: 1}, &mockStruct{Prop: 1})
+
+	if tester.Failed() {
+		t.Error("NotSame did not pass when the pointers pointed to distinct values")
+	}
+}
+
+func Test_NotSameShouldFail_GivenPointersToSameValue(t *testing.T) {
+	tester := new(testing.T)
+	value := 42
+
+	NotSame(tester, &value, &value)
+
+	if !tester.Failed() {
+		t.Error("NotSame did not fail when the pointers pointed to the same value")
+	}
+}
+
+func Test_PointeeEqualShouldPass_GivenPointerToEqualValue(t *testing.T) {
+	tester := new(testing.T)
+
+	PointeeEqual(tester, mockStruct{Prop: 1}, &mockStruct{Prop: 1})
+
+	if tester.Failed() {
+		t.Error("PointeeEqual did not pass when the pointer pointed to an equal value")
+	}
+}
+
+func Test_PointeeEqualShouldReportNil_GivenNilPointer(t *testing.T) {
+	tester := newRecordingT()
+
+	PointeeEqual[mockStruct](tester, mockStruct{Prop: 1}, nil)
+
+	if tester.output() != "Expected a pointer to goassert.mockStruct{Prop: 1} but got nil" {
+		t.Errorf("PointeeEqual did not report the nil pointer but got %q", tester.output())
+	}
+}
+
+func Test_PointeeEqualShouldReportAddressAndValues_GivenPointerToDifferentValue(t *testing.T) {
+	tester := newRecordingT()
+	value := 2
+
+	PointeeEqual(tester, 1, &value)
+
+	if !regexp.MustCompile(`^Unexpected value pointed to by 0x[0-9a-f]+\. Expected: 1\. Actual: 2$`).MatchString(tester.output()) {
+		t.Errorf("PointeeEqual did not report the address and values but got %q", tester.output())
+	}
+}
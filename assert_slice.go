@@ -9,13 +9,13 @@ func EmptySlice[T any](t testing.TB, s []T) {
 	t.Helper()
 
 	if s == nil {
-		t.Error("Expected empty slice but got nil")
+		fail(t, "Expected empty slice but got nil")
 		return
 	}
 
 	length := len(s)
 	if length != 0 {
-		t.Errorf("Expected empty slice but got slice with length %d", length)
+		failf(t, "Expected empty slice but got slice with length %d", length)
 	}
 }
 
@@ -26,12 +26,12 @@ func NotEmptySlice[T any](t testing.TB, s []T) {
 	t.Helper()
 
 	if s == nil {
-		t.Error("Expected empty slice but got nil")
+		fail(t, "Expected empty slice but got nil")
 		return
 	}
 
 	if len(s) == 0 {
-		t.Error("Expected non- empty slice but got empty slice")
+		fail(t, "Expected non- empty slice but got empty slice")
 	}
 }
 
@@ -43,7 +43,7 @@ func SliceLength[T any](t testing.TB, s []T, expectedLength int) {
 
 	length := len(s)
 	if length != expectedLength {
-		t.Errorf("Expected slice to have length of %d but got %d", expectedLength, length)
+		failf(t, "Expected slice to have length of %d but got %d", expectedLength, length)
 	}
 }
 
@@ -54,7 +54,7 @@ func SliceContains[K comparable](t testing.TB, s []K, element K) {
 	t.Helper()
 
 	if !sliceContains(s, element) {
-		t.Errorf("Element %v could not be found in the slice %v", element, s)
+		failf(t, "Element %v could not be found in the slice %v", element, s)
 	}
 }
 
@@ -65,10 +65,163 @@ func SliceNotContains[K comparable](t testing.TB, s []K, element K) {
 	t.Helper()
 
 	if sliceContains(s, element) {
-		t.Errorf("Element %v was not expected to be found in the slice %v", element, s)
+		failf(t, "Element %v was not expected to be found in the slice %v", element, s)
 	}
 }
 
+/*
+Asserts that every element of expected can be found in actual, i.e. expected is a subset of actual.
+Elements are compared as a set, so duplicates are ignored. The elements must be [comparable]
+*/
+func SliceSubset[K comparable](t testing.TB, expected []K, actual []K) {
+	t.Helper()
+
+	missing := elementsNotIn(expected, actual)
+	if len(missing) > 0 {
+		failf(t, "Expected all elements of %v to be found in the slice %v but elements %v were missing", expected, actual, missing)
+	}
+}
+
+/*
+Asserts that every element of actual can be found in expected, i.e. expected is a superset of actual.
+Elements are compared as a set, so duplicates are ignored. The elements must be [comparable]
+*/
+func SliceSuperset[K comparable](t testing.TB, expected []K, actual []K) {
+	t.Helper()
+
+	unexpected := elementsNotIn(actual, expected)
+	if len(unexpected) > 0 {
+		failf(t, "Expected all elements of the slice %v to be found in %v but elements %v were unexpected", actual, expected, unexpected)
+	}
+}
+
+/*
+Asserts that the two given slices have no elements in common. The elements must be [comparable]
+*/
+func SliceDisjoint[K comparable](t testing.TB, a []K, b []K) {
+	t.Helper()
+
+	common := elementsIn(a, b)
+	if len(common) > 0 {
+		failf(t, "Expected slices %v and %v to have no elements in common but both contain %v", a, b, common)
+	}
+}
+
+/*
+Asserts that the elements of the given slice are in non-decreasing order
+*/
+func SliceSorted[T Ordered](t testing.TB, s []T) {
+	t.Helper()
+
+	i := firstUnorderedIndex(s, func(a, b T) bool { return a <= b })
+	if i >= 0 {
+		failf(t, "Expected slice to be sorted but element %v at index %d is greater than element %v at index %d",
+			s[i], i, s[i+1], i+1)
+	}
+}
+
+/*
+Asserts that the elements of the given slice are sorted according to the given less function.
+Equal elements, for which neither less(a, b) nor less(b, a) holds, may appear in any order
+*/
+func SliceSortedFunc[T any](t testing.TB, s []T, less func(a, b T) bool) {
+	t.Helper()
+
+	i := firstUnorderedIndex(s, func(a, b T) bool { return !less(b, a) })
+	if i >= 0 {
+		failf(t, "Expected slice to be sorted but element %v at index %d is out of order with element %v at index %d",
+			s[i], i, s[i+1], i+1)
+	}
+}
+
+/*
+Asserts that the keys extracted from the elements of the given slice with the given function are in non-decreasing order
+*/
+func SliceSortedBy[T any, K Ordered](t testing.TB, s []T, key func(T) K) {
+	t.Helper()
+
+	i := firstUnorderedIndex(s, func(a, b T) bool { return key(a) <= key(b) })
+	if i >= 0 {
+		failf(t, "Expected slice to be sorted by key but element %v at index %d with key %v is greater than element %v at index %d with key %v",
+			s[i], i, key(s[i]), s[i+1], i+1, key(s[i+1]))
+	}
+}
+
+/*
+Asserts that every element of the given slice is strictly less than the element following it
+*/
+func SliceStrictlyIncreasing[T Ordered](t testing.TB, s []T) {
+	t.Helper()
+
+	i := firstUnorderedIndex(s, func(a, b T) bool { return a < b })
+	if i >= 0 {
+		failf(t, "Expected slice to be strictly increasing but element %v at index %d is not less than element %v at index %d",
+			s[i], i, s[i+1], i+1)
+	}
+}
+
+/*
+Asserts that the elements of the given slice are in non-increasing order
+*/
+func SliceDecreasing[T Ordered](t testing.TB, s []T) {
+	t.Helper()
+
+	i := firstUnorderedIndex(s, func(a, b T) bool { return a >= b })
+	if i >= 0 {
+		failf(t, "Expected slice to be decreasing but element %v at index %d is less than element %v at index %d",
+			s[i], i, s[i+1], i+1)
+	}
+}
+
+/*
+Returns the index of the first element that is not in order with the element following it, or -1 if all elements are in order
+*/
+func firstUnorderedIndex[T any](s []T, inOrder func(a, b T) bool) int {
+	for i := 0; i+1 < len(s); i++ {
+		if !inOrder(s[i], s[i+1]) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+/*
+Returns the distinct elements of from that are not in other, in the order of their first occurrence
+*/
+func elementsNotIn[K comparable](from []K, other []K) []K {
+	return filterDistinct(from, other, false)
+}
+
+/*
+Returns the distinct elements of from that are also in other, in the order of their first occurrence
+*/
+func elementsIn[K comparable](from []K, other []K) []K {
+	return filterDistinct(from, other, true)
+}
+
+func filterDistinct[K comparable](from []K, other []K, keepContained bool) []K {
+	otherSet := make(map[K]struct{}, len(other))
+	for _, v := range other {
+		otherSet[v] = struct{}{}
+	}
+
+	seen := make(map[K]struct{}, len(from))
+	var result []K
+	for _, v := range from {
+		if _, alreadySeen := seen[v]; alreadySeen {
+			continue
+		}
+		seen[v] = struct{}{}
+
+		if _, contained := otherSet[v]; contained == keepContained {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
 func sliceContains[K comparable](s []K, element K) bool {
 	for _, v := range s {
 		if v == element {
@@ -78,3 +231,25 @@ func sliceContains[K comparable](s []K, element K) bool {
 
 	return false
 }
+
+/*
+Asserts that the given slice contains at least one element satisfying the given matcher
+*/
+func SliceContainsMatch[T any](t testing.TB, s []T, matcher Matcher[T]) {
+	t.Helper()
+
+	if indexOfMatch(s, matcher) < 0 {
+		failf(t, "Expected the slice %v to contain an element %s but it did not", s, matcher.Describe())
+	}
+}
+
+/*
+Asserts that the given slice does not contain any element satisfying the given matcher
+*/
+func SliceNotContainsMatch[T any](t testing.TB, s []T, matcher Matcher[T]) {
+	t.Helper()
+
+	if i := indexOfMatch(s, matcher); i >= 0 {
+		failf(t, "Expected the slice %v to not contain an element %s but element %v at index %d was", s, matcher.Describe(), s[i], i)
+	}
+}
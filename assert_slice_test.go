@@ -1,6 +1,9 @@
 package goassert
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func Test_EmptySliceShouldPass_GivenEmptySlice(t *testing.T) {
 	tester := new(testing.T)
@@ -145,3 +148,218 @@ func Test_SliceNotContainsShouldFail_IfGivenElementExistsInGivenSlice(t *testing
 		t.Error("SliceNotContains did not fail when given element is not found within given slice")
 	}
 }
+
+func Test_SliceSortedShouldPass_GivenSortedSliceWithDuplicates(t *testing.T) {
+	tester := new(testing.T)
+
+	SliceSorted(tester, []int{3, 5, 5, 10, 16})
+
+	if tester.Failed() {
+		t.Error("SliceSorted did not pass when sorted slice with duplicates was given")
+	}
+}
+
+func Test_SliceSortedShouldPass_GivenEmptySlice(t *testing.T) {
+	tester := new(testing.T)
+
+	SliceSorted(tester, []string{})
+
+	if tester.Failed() {
+		t.Error("SliceSorted did not pass when empty slice was given")
+	}
+}
+
+func Test_SliceSortedShouldReportFirstUnorderedPair_GivenUnsortedSlice(t *testing.T) {
+	tester := newRecordingT()
+
+	SliceSorted(tester, []int{3, 5, 16, 10, 8})
+
+	expected := "Expected slice to be sorted but element 16 at index 2 is greater than element 10 at index 3"
+	if tester.output() != expected {
+		t.Errorf("SliceSorted did not report the first unordered pair but got %q", tester.output())
+	}
+}
+
+func Test_SliceSortedFuncShouldPass_GivenSliceSortedByLessFunc(t *testing.T) {
+	tester := new(testing.T)
+
+	slice := []*mockStruct{newMockStruct(16), newMockStruct(10), newMockStruct(10), newMockStruct(3)}
+	SliceSortedFunc(tester, slice, func(a, b *mockStruct) bool { return a.Prop > b.Prop })
+
+	if tester.Failed() {
+		t.Error("SliceSortedFunc did not pass when slice sorted by the less func was given")
+	}
+}
+
+func Test_SliceSortedFuncShouldFail_GivenSliceNotSortedByLessFunc(t *testing.T) {
+	tester := new(testing.T)
+
+	slice := []*mockStruct{newMockStruct(3), newMockStruct(10)}
+	SliceSortedFunc(tester, slice, func(a, b *mockStruct) bool { return a.Prop > b.Prop })
+
+	if !tester.Failed() {
+		t.Error("SliceSortedFunc did not fail when slice not sorted by the less func was given")
+	}
+}
+
+func Test_SliceSortedByShouldPass_GivenSliceSortedByKey(t *testing.T) {
+	tester := new(testing.T)
+
+	slice := []mockStruct{{Prop: 3}, {Prop: 10}, {Prop: 16}}
+	SliceSortedBy(tester, slice, func(m mockStruct) int { return m.Prop })
+
+	if tester.Failed() {
+		t.Error("SliceSortedBy did not pass when slice sorted by key was given")
+	}
+}
+
+func Test_SliceSortedByShouldFail_GivenSliceNotSortedByKey(t *testing.T) {
+	tester := new(testing.T)
+
+	slice := []mockStruct{{Prop: 3}, {Prop: 16}, {Prop: 10}}
+	SliceSortedBy(tester, slice, func(m mockStruct) int { return m.Prop })
+
+	if !tester.Failed() {
+		t.Error("SliceSortedBy did not fail when slice not sorted by key was given")
+	}
+}
+
+func Test_SliceStrictlyIncreasingShouldPass_GivenStrictlyIncreasingSlice(t *testing.T) {
+	tester := new(testing.T)
+
+	SliceStrictlyIncreasing(tester, []float64{-1.5, 0, 3.2})
+
+	if tester.Failed() {
+		t.Error("SliceStrictlyIncreasing did not pass when strictly increasing slice was given")
+	}
+}
+
+func Test_SliceStrictlyIncreasingShouldFail_GivenSliceWithDuplicates(t *testing.T) {
+	tester := new(testing.T)
+
+	SliceStrictlyIncreasing(tester, []int{3, 5, 5, 10})
+
+	if !tester.Failed() {
+		t.Error("SliceStrictlyIncreasing did not fail when slice with duplicates was given")
+	}
+}
+
+func Test_SliceDecreasingShouldPass_GivenDecreasingSlice(t *testing.T) {
+	tester := new(testing.T)
+
+	SliceDecreasing(tester, []string{"c", "b", "b", "a"})
+
+	if tester.Failed() {
+		t.Error("SliceDecreasing did not pass when decreasing slice was given")
+	}
+}
+
+func Test_SliceDecreasingShouldFail_GivenIncreasingSlice(t *testing.T) {
+	tester := new(testing.T)
+
+	SliceDecreasing(tester, []int{16, 10, 12})
+
+	if !tester.Failed() {
+		t.Error("SliceDecreasing did not fail when increasing slice was given")
+	}
+}
+
+func Test_SliceSubsetShouldPass_WhenAllExpectedElementsAreInActual(t *testing.T) {
+	tester := new(testing.T)
+
+	SliceSubset(tester, []int{16, 3, 3}, []int{3, 10, 5, 16})
+
+	if tester.Failed() {
+		t.Error("SliceSubset did not pass when all expected elements were in actual")
+	}
+}
+
+func Test_SliceSubsetShouldReportMissingElements_WhenExpectedElementsAreNotInActual(t *testing.T) {
+	tester := newRecordingT()
+
+	SliceSubset(tester, []int{3, 7, 8, 7}, []int{3, 10})
+
+	if !tester.Failed() || !strings.HasSuffix(tester.output(), "but elements [7 8] were missing") {
+		t.Errorf("SliceSubset did not report the missing elements but got %q", tester.output())
+	}
+}
+
+func Test_SliceSupersetShouldPass_WhenAllActualElementsAreInExpected(t *testing.T) {
+	tester := new(testing.T)
+
+	SliceSuperset(tester, []string{"a", "b", "c"}, []string{"c", "a"})
+
+	if tester.Failed() {
+		t.Error("SliceSuperset did not pass when all actual elements were in expected")
+	}
+}
+
+func Test_SliceSupersetShouldReportUnexpectedElements_WhenActualElementsAreNotInExpected(t *testing.T) {
+	tester := newRecordingT()
+
+	SliceSuperset(tester, []string{"a", "b"}, []string{"a", "d", "e"})
+
+	if !tester.Failed() || !strings.HasSuffix(tester.output(), "but elements [d e] were unexpected") {
+		t.Errorf("SliceSuperset did not report the unexpected elements but got %q", tester.output())
+	}
+}
+
+func Test_SliceDisjointShouldPass_GivenSlicesWithoutCommonElements(t *testing.T) {
+	tester := new(testing.T)
+
+	SliceDisjoint(tester, []int{3, 5}, []int{10, 16})
+
+	if tester.Failed() {
+		t.Error("SliceDisjoint did not pass when given slices without common elements")
+	}
+}
+
+func Test_SliceDisjointShouldReportCommonElements_GivenSlicesWithCommonElements(t *testing.T) {
+	tester := newRecordingT()
+
+	SliceDisjoint(tester, []int{3, 5, 10}, []int{10, 16, 3})
+
+	if !tester.Failed() || !strings.HasSuffix(tester.output(), "but both contain [3 10]") {
+		t.Errorf("SliceDisjoint did not report the common elements but got %q", tester.output())
+	}
+}
+
+func Test_SliceContainsMatchShouldPass_GivenSliceWithMatchingElement(t *testing.T) {
+	tester := new(testing.T)
+
+	SliceContainsMatch(tester, []mockUser{{Name: "Ann"}, {Name: "Bob"}}, Field[mockUser]("Name", Eq("Bob")))
+
+	if tester.Failed() {
+		t.Error("SliceContainsMatch did not pass when the slice contained a matching element")
+	}
+}
+
+func Test_SliceContainsMatchShouldFail_GivenSliceWithoutMatchingElement(t *testing.T) {
+	tester := new(testing.T)
+
+	SliceContainsMatch(tester, []int{1, 2, 3}, GreaterThan(3))
+
+	if !tester.Failed() {
+		t.Error("SliceContainsMatch did not fail when the slice did not contain a matching element")
+	}
+}
+
+func Test_SliceNotContainsMatchShouldPass_GivenSliceWithoutMatchingElement(t *testing.T) {
+	tester := new(testing.T)
+
+	SliceNotContainsMatch(tester, []int{1, 2, 3}, GreaterThan(3))
+
+	if tester.Failed() {
+		t.Error("SliceNotContainsMatch did not pass when the slice did not contain a matching element")
+	}
+}
+
+func Test_SliceNotContainsMatchShouldFail_GivenSliceWithMatchingElement(t *testing.T) {
+	tester := new(testing.T)
+
+	SliceNotContainsMatch(tester, []int{1, 2, 3}, GreaterThan(2))
+
+	if !tester.Failed() {
+		t.Error("SliceNotContainsMatch did not fail when the slice contained a matching element")
+	}
+}
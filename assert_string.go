@@ -0,0 +1,162 @@
+package goassert
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+const maxDisplayedStringLength = 512
+
+/*
+Asserts that the given string contains the given substring
+*/
+func StringContains(t testing.TB, s string, substring string) {
+	t.Helper()
+
+	if !strings.Contains(s, substring) {
+		failf(t, "Expected %s to contain %s", quoteTruncated(s, truncateMiddle), quoteTruncated(substring, truncateMiddle))
+	}
+}
+
+/*
+Asserts that the given string does not contain the given substring
+*/
+func StringNotContains(t testing.TB, s string, substring string) {
+	t.Helper()
+
+	if strings.Contains(s, substring) {
+		failf(t, "Expected %s to not contain %s", quoteTruncated(s, truncateMiddle), quoteTruncated(substring, truncateMiddle))
+	}
+}
+
+/*
+Asserts that the given string starts with the given prefix
+*/
+func HasPrefix(t testing.TB, s string, prefix string) {
+	t.Helper()
+
+	if !strings.HasPrefix(s, prefix) {
+		failf(t, "Expected %s to have prefix %s", quoteTruncated(s, truncateEnd), quoteTruncated(prefix, truncateEnd))
+	}
+}
+
+/*
+Asserts that the given string ends with the given suffix
+*/
+func HasSuffix(t testing.TB, s string, suffix string) {
+	t.Helper()
+
+	if !strings.HasSuffix(s, suffix) {
+		failf(t, "Expected %s to have suffix %s", quoteTruncated(s, truncateStart), quoteTruncated(suffix, truncateStart))
+	}
+}
+
+/*
+Asserts that the given string matches the given regular expression.
+The regular expression can be given as a pattern string or as a compiled *regexp.Regexp
+*/
+func Regexp[P string | *regexp.Regexp](t testing.TB, s string, pattern P) {
+	t.Helper()
+
+	re, err := compileRegexp(pattern)
+	if err != nil {
+		failf(t, "Invalid regular expression: %v", err)
+		return
+	}
+
+	if !re.MatchString(s) {
+		failf(t, "Expected %s to match regular expression %s", quoteTruncated(s, truncateMiddle), strconv.Quote(re.String()))
+	}
+}
+
+/*
+Asserts that the given string does not match the given regular expression.
+The regular expression can be given as a pattern string or as a compiled *regexp.Regexp
+*/
+func NotRegexp[P string | *regexp.Regexp](t testing.TB, s string, pattern P) {
+	t.Helper()
+
+	re, err := compileRegexp(pattern)
+	if err != nil {
+		failf(t, "Invalid regular expression: %v", err)
+		return
+	}
+
+	if re.MatchString(s) {
+		failf(t, "Expected %s to not match regular expression %s but it matched %s",
+			quoteTruncated(s, truncateMiddle), strconv.Quote(re.String()), quoteTruncated(re.FindString(s), truncateMiddle))
+	}
+}
+
+/*
+Asserts that the two given strings are equal under simple Unicode case-folding. Internally uses strings.EqualFold
+*/
+func EqualFold(t testing.TB, expected string, actual string) {
+	t.Helper()
+
+	if !strings.EqualFold(expected, actual) {
+		failf(t, "Expected %s to equal %s ignoring case", quoteTruncated(actual, truncateMiddle), quoteTruncated(expected, truncateMiddle))
+	}
+}
+
+/*
+Asserts that the given string has the given length, counted in runes rather than bytes
+*/
+func StringLength(t testing.TB, s string, expectedLength int) {
+	t.Helper()
+
+	length := utf8.RuneCountInString(s)
+	if length != expectedLength {
+		failf(t, "Expected string %s to have length of %d runes but got %d", quoteTruncated(s, truncateMiddle), expectedLength, length)
+	}
+}
+
+func compileRegexp[P string | *regexp.Regexp](pattern P) (*regexp.Regexp, error) {
+	switch p := interface{}(pattern).(type) {
+	case string:
+		return regexp.Compile(p)
+	case *regexp.Regexp:
+		if p == nil {
+			return nil, errors.New("nil *regexp.Regexp")
+		}
+		return p, nil
+	}
+
+	return nil, fmt.Errorf("unsupported regular expression type %T", pattern)
+}
+
+type truncation int
+
+const (
+	truncateEnd truncation = iota
+	truncateStart
+	truncateMiddle
+)
+
+/*
+Quotes the given string, escaping control and non-printable characters. Strings longer than maxDisplayedStringLength runes
+are truncated, keeping the part that matters for the assertion: the start for prefixes, the end for suffixes and both ends otherwise
+*/
+func quoteTruncated(s string, mode truncation) string {
+	runes := []rune(s)
+	if len(runes) <= maxDisplayedStringLength {
+		return strconv.Quote(s)
+	}
+
+	omitted := len(runes) - maxDisplayedStringLength
+	switch mode {
+	case truncateEnd:
+		return fmt.Sprintf("%s... (%d more runes)", strconv.Quote(string(runes[:maxDisplayedStringLength])), omitted)
+	case truncateStart:
+		return fmt.Sprintf("(%d more runes) ...%s", omitted, strconv.Quote(string(runes[omitted:])))
+	}
+
+	half := maxDisplayedStringLength / 2
+	return fmt.Sprintf("%s ... (%d more runes) ... %s",
+		strconv.Quote(string(runes[:half])), omitted, strconv.Quote(string(runes[len(runes)-half:])))
+}
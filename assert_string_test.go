@@ -0,0 +1,198 @@
+package goassert
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func Test_StringContainsShouldPass_WhenStringContainsSubstring(t *testing.T) {
+	tester := new(testing.T)
+
+	StringContains(tester, "hello world", "o w")
+
+	if tester.Failed() {
+		t.Error("StringContains did not pass when the string contained the substring")
+	}
+}
+
+func Test_StringContainsShouldReportEscapedString_WhenStringDoesNotContainSubstring(t *testing.T) {
+	tester := newRecordingT()
+
+	StringContains(tester, "hello\tworld\n", "planet")
+
+	if tester.output() != `Expected "hello\tworld\n" to contain "planet"` {
+		t.Errorf("StringContains did not report the escaped string but got %q", tester.output())
+	}
+}
+
+func Test_StringContainsShouldTruncateMiddle_GivenLongString(t *testing.T) {
+	tester := newRecordingT()
+
+	StringContains(tester, "start"+strings.Repeat("x", 1000)+"end", "planet")
+
+	output := tester.output()
+	if !strings.Contains(output, `"startxx`) || !strings.Contains(output, `xxend"`) || !strings.Contains(output, "(496 more runes)") {
+		t.Errorf("StringContains did not truncate the middle of the long string but got %q", output)
+	}
+}
+
+func Test_StringNotContainsShouldPass_WhenStringDoesNotContainSubstring(t *testing.T) {
+	tester := new(testing.T)
+
+	StringNotContains(tester, "hello world", "planet")
+
+	if tester.Failed() {
+		t.Error("StringNotContains did not pass when the string did not contain the substring")
+	}
+}
+
+func Test_StringNotContainsShouldFail_WhenStringContainsSubstring(t *testing.T) {
+	tester := new(testing.T)
+
+	StringNotContains(tester, "hello world", "world")
+
+	if !tester.Failed() {
+		t.Error("StringNotContains did not fail when the string contained the substring")
+	}
+}
+
+func Test_HasPrefixShouldPass_WhenStringStartsWithPrefix(t *testing.T) {
+	tester := new(testing.T)
+
+	HasPrefix(tester, "SELECT * FROM users", "SELECT")
+
+	if tester.Failed() {
+		t.Error("HasPrefix did not pass when the string started with the prefix")
+	}
+}
+
+func Test_HasPrefixShouldKeepStart_GivenLongString(t *testing.T) {
+	tester := newRecordingT()
+
+	HasPrefix(tester, "INSERT"+strings.Repeat("x", 1000), "SELECT")
+
+	if !strings.HasPrefix(tester.output(), `Expected "INSERTxx`) || !strings.Contains(tester.output(), "... (494 more runes)") {
+		t.Errorf("HasPrefix did not keep the start of the long string but got %q", tester.output())
+	}
+}
+
+func Test_HasSuffixShouldPass_WhenStringEndsWithSuffix(t *testing.T) {
+	tester := new(testing.T)
+
+	HasSuffix(tester, "report.csv", ".csv")
+
+	if tester.Failed() {
+		t.Error("HasSuffix did not pass when the string ended with the suffix")
+	}
+}
+
+func Test_HasSuffixShouldKeepEnd_GivenLongString(t *testing.T) {
+	tester := newRecordingT()
+
+	HasSuffix(tester, strings.Repeat("x", 1000)+".json", ".csv")
+
+	if !strings.Contains(tester.output(), `xx.json" to have suffix ".csv"`) {
+		t.Errorf("HasSuffix did not keep the end of the long string but got %q", tester.output())
+	}
+}
+
+func Test_RegexpShouldPass_GivenMatchingPatternString(t *testing.T) {
+	tester := new(testing.T)
+
+	Regexp(tester, "order-1234", `^order-\d+$`)
+
+	if tester.Failed() {
+		t.Error("Regexp did not pass when given a matching pattern string")
+	}
+}
+
+func Test_RegexpShouldPass_GivenMatchingCompiledRegexp(t *testing.T) {
+	tester := new(testing.T)
+
+	Regexp(tester, "order-1234", regexp.MustCompile(`\d{4}`))
+
+	if tester.Failed() {
+		t.Error("Regexp did not pass when given a matching compiled regexp")
+	}
+}
+
+func Test_RegexpShouldFail_GivenNonMatchingPattern(t *testing.T) {
+	tester := new(testing.T)
+
+	Regexp(tester, "order-abc", `^order-\d+$`)
+
+	if !tester.Failed() {
+		t.Error("Regexp did not fail when given a non-matching pattern")
+	}
+}
+
+func Test_RegexpShouldFail_GivenInvalidPattern(t *testing.T) {
+	tester := new(testing.T)
+
+	Regexp(tester, "order", `(`)
+
+	if !tester.Failed() {
+		t.Error("Regexp did not fail when given an invalid pattern")
+	}
+}
+
+func Test_NotRegexpShouldPass_GivenNonMatchingPattern(t *testing.T) {
+	tester := new(testing.T)
+
+	NotRegexp(tester, "order-abc", `\d`)
+
+	if tester.Failed() {
+		t.Error("NotRegexp did not pass when given a non-matching pattern")
+	}
+}
+
+func Test_NotRegexpShouldReportMatch_GivenMatchingPattern(t *testing.T) {
+	tester := newRecordingT()
+
+	NotRegexp(tester, "order-42", regexp.MustCompile(`\d+`))
+
+	if !tester.Failed() || !strings.HasSuffix(tester.output(), `but it matched "42"`) {
+		t.Errorf("NotRegexp did not report the match but got %q", tester.output())
+	}
+}
+
+func Test_EqualFoldShouldPass_GivenStringsDifferingInCase(t *testing.T) {
+	tester := new(testing.T)
+
+	EqualFold(tester, "Straße", "STRAßE")
+
+	if tester.Failed() {
+		t.Error("EqualFold did not pass when given strings differing in case")
+	}
+}
+
+func Test_EqualFoldShouldFail_GivenDifferentStrings(t *testing.T) {
+	tester := new(testing.T)
+
+	EqualFold(tester, "Go", "Gopher")
+
+	if !tester.Failed() {
+		t.Error("EqualFold did not fail when given different strings")
+	}
+}
+
+func Test_StringLengthShouldPass_GivenStringWithMultiByteRunes(t *testing.T) {
+	tester := new(testing.T)
+
+	StringLength(tester, "héllo", 5)
+
+	if tester.Failed() {
+		t.Error("StringLength did not count the runes of the string")
+	}
+}
+
+func Test_StringLengthShouldFail_GivenStringWithDifferentLength(t *testing.T) {
+	tester := new(testing.T)
+
+	StringLength(tester, "hello", 4)
+
+	if !tester.Failed() {
+		t.Error("StringLength did not fail when given a string with different length")
+	}
+}
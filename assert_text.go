@@ -0,0 +1,141 @@
+package goassert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const whitespaceLegend = "(· trailing space, → tab, ␍ carriage return, ↵ line feed)"
+
+/*
+Asserts that the two given texts are equal after applying the given options.
+Failures are reported as a line diff in which trailing spaces, tabs and line endings are made visible
+*/
+func EqualText(t testing.TB, expected string, actual string, options ...TextOption) {
+	t.Helper()
+
+	var config textConfig
+	for _, option := range options {
+		option(&config)
+	}
+
+	normalizedExpected := config.normalize(expected)
+	normalizedActual := config.normalize(actual)
+	if normalizedExpected != normalizedActual {
+		fail(t, textInequalityMsg(normalizedExpected, normalizedActual))
+	}
+}
+
+/*
+TextOption configures how [EqualText] compares texts
+*/
+type TextOption func(*textConfig)
+
+/*
+Ignores leading and trailing whitespace on each line and treats runs of whitespace within a line as a single space
+*/
+func IgnoreWhitespace() TextOption {
+	return func(config *textConfig) {
+		config.ignoreWhitespace = true
+	}
+}
+
+/*
+Ignores line feeds at the end of the texts
+*/
+func IgnoreTrailingNewline() TextOption {
+	return func(config *textConfig) {
+		config.ignoreTrailingNewline = true
+	}
+}
+
+/*
+Treats CRLF and CR line endings as LF
+*/
+func NormalizeLineEndings() TextOption {
+	return func(config *textConfig) {
+		config.normalizeLineEndings = true
+	}
+}
+
+type textConfig struct {
+	ignoreWhitespace      bool
+	ignoreTrailingNewline bool
+	normalizeLineEndings  bool
+}
+
+func (c textConfig) normalize(text string) string {
+	if c.normalizeLineEndings {
+		text = strings.ReplaceAll(text, "\r\n", "\n")
+		text = strings.ReplaceAll(text, "\r", "\n")
+	}
+
+	if c.ignoreWhitespace {
+		lines := strings.Split(text, "\n")
+		for i, line := range lines {
+			lines[i] = strings.Join(strings.Fields(line), " ")
+		}
+		text = strings.Join(lines, "\n")
+	}
+
+	if c.ignoreTrailingNewline {
+		text = strings.TrimRight(text, "\n")
+	}
+
+	return text
+}
+
+/*
+Returns the given value as a string if it holds one, looking through interfaces
+*/
+func stringValue(v reflect.Value) (string, bool) {
+	for v.IsValid() && v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	if !v.IsValid() || v.Kind() != reflect.String {
+		return "", false
+	}
+
+	return v.String(), true
+}
+
+func isMultilineText(text string) bool {
+	return strings.ContainsAny(text, "\n\r")
+}
+
+func textInequalityMsg(expected string, actual string) string {
+	return fmt.Sprintf("Expected and actual texts are not equal %s\n%s",
+		whitespaceLegend, unifiedLineDiff(visibleLines(expected), visibleLines(actual)))
+}
+
+/*
+Splits the given text into lines and makes their whitespace visible:
+trailing spaces become ·, tabs become →, carriage returns become ␍ and every line feed is kept as a trailing ↵,
+so a missing final line feed or a CRLF line ending shows up in the diff
+*/
+func visibleLines(text string) []string {
+	pieces := strings.SplitAfter(text, "\n")
+	if len(pieces) > 1 && pieces[len(pieces)-1] == "" {
+		pieces = pieces[:len(pieces)-1]
+	}
+
+	lines := make([]string, len(pieces))
+	for i, piece := range pieces {
+		ending := ""
+		if strings.HasSuffix(piece, "\n") {
+			piece = strings.TrimSuffix(piece, "\n")
+			ending = "↵"
+		}
+
+		content := strings.TrimRight(piece, " ")
+		trailingSpaces := len(piece) - len(content)
+		content = strings.ReplaceAll(content, "\t", "→")
+		content = strings.ReplaceAll(content, "\r", "␍")
+
+		lines[i] = content + strings.Repeat("·", trailingSpaces) + ending
+	}
+
+	return lines
+}
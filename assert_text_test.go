@@ -0,0 +1,103 @@
+package goassert
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_EqualTextShouldPass_GivenEqualTexts(t *testing.T) {
+	tester := new(testing.T)
+
+	EqualText(tester, "SELECT *\nFROM users\n", "SELECT *\nFROM users\n")
+
+	if tester.Failed() {
+		t.Error("EqualText did not pass when the texts were equal")
+	}
+}
+
+func Test_EqualTextShouldFail_GivenTextsWithDifferentTrailingWhitespace(t *testing.T) {
+	tester := new(testing.T)
+
+	EqualText(tester, "SELECT *\nFROM users\n", "SELECT * \nFROM users\n")
+
+	if !tester.Failed() {
+		t.Error("EqualText did not fail when the texts had different trailing whitespace")
+	}
+}
+
+func Test_EqualTextShouldPass_GivenIgnoreWhitespaceAndTextsWithDifferentWhitespace(t *testing.T) {
+	tester := new(testing.T)
+
+	EqualText(tester, "SELECT *\n\tFROM  users\n", "  SELECT * \nFROM users\n", IgnoreWhitespace())
+
+	if tester.Failed() {
+		t.Error("EqualText did not pass with IgnoreWhitespace when the texts only differed in whitespace")
+	}
+}
+
+func Test_EqualTextShouldFail_GivenIgnoreWhitespaceAndTextsWithDifferentWords(t *testing.T) {
+	tester := new(testing.T)
+
+	EqualText(tester, "SELECT *\nFROM users\n", "SELECT *\nFROM groups\n", IgnoreWhitespace())
+
+	if !tester.Failed() {
+		t.Error("EqualText did not fail with IgnoreWhitespace when the texts had different words")
+	}
+}
+
+func Test_EqualTextShouldPass_GivenIgnoreTrailingNewlineAndTextsWithDifferentTrailingNewlines(t *testing.T) {
+	tester := new(testing.T)
+
+	EqualText(tester, "line 1\nline 2\n\n", "line 1\nline 2", IgnoreTrailingNewline())
+
+	if tester.Failed() {
+		t.Error("EqualText did not pass with IgnoreTrailingNewline when the texts only differed in trailing newlines")
+	}
+}
+
+func Test_EqualTextShouldPass_GivenNormalizeLineEndingsAndTextsWithDifferentLineEndings(t *testing.T) {
+	tester := new(testing.T)
+
+	EqualText(tester, "line 1\nline 2\n", "line 1\r\nline 2\r\n", NormalizeLineEndings())
+
+	if tester.Failed() {
+		t.Error("EqualText did not pass with NormalizeLineEndings when the texts only differed in line endings")
+	}
+}
+
+func Test_EqualTextShouldFail_GivenTextsWithDifferentLineEndings(t *testing.T) {
+	tester := newRecordingT()
+
+	EqualText(tester, "line 1\nline 2\n", "line 1\r\nline 2\n")
+
+	output := tester.output()
+	if !strings.Contains(output, "-line 1↵") || !strings.Contains(output, "+line 1␍↵") {
+		t.Errorf("EqualText did not make the line endings visible but got:\n%s", output)
+	}
+}
+
+func Test_VisibleLinesShouldMarkWhitespace_GivenTextWithTabsAndTrailingSpaces(t *testing.T) {
+	lines := visibleLines("\tindented  \nlast")
+
+	if len(lines) != 2 || lines[0] != "→indented··↵" || lines[1] != "last" {
+		t.Errorf("visibleLines did not mark the whitespace but got %q", lines)
+	}
+}
+
+func Test_InequalityMsgShouldRenderTextDiff_GivenMultilineStrings(t *testing.T) {
+	msg := inequalityMsg("SELECT *\nFROM users\nWHERE id = 1\n", "SELECT *\nFROM users \nWHERE id = 1\n")
+
+	for _, expectedLine := range []string{"--- expected", "+++ actual", "-FROM users↵", "+FROM users·↵", " WHERE id = 1↵"} {
+		if !strings.Contains(msg, expectedLine) {
+			t.Errorf("inequalityMsg did not contain line %q but got:\n%s", expectedLine, msg)
+		}
+	}
+}
+
+func Test_InequalityMsgShouldRenderTextDiff_GivenMultilineStringsInInterfaces(t *testing.T) {
+	msg := inequalityMsg[interface{}]("a\nb", "a\nc")
+
+	if !strings.Contains(msg, "-b") || !strings.Contains(msg, "+c") {
+		t.Errorf("inequalityMsg did not render a text diff for strings held in interfaces but got:\n%s", msg)
+	}
+}
@@ -9,7 +9,7 @@ func True(t testing.TB, assertion bool) {
 	t.Helper()
 
 	if !assertion {
-		t.Error(inequalityMsg(true, false))
+		fail(t, inequalityMsg(true, false))
 	}
 }
 
@@ -20,6 +20,6 @@ func False(t testing.TB, assertion bool) {
 	t.Helper()
 
 	if assertion {
-		t.Error(inequalityMsg(false, true))
+		fail(t, inequalityMsg(false, true))
 	}
 }
@@ -0,0 +1,97 @@
+package goassert
+
+import (
+	"reflect"
+	"testing"
+)
+
+/*
+Asserts that the given value is of type T, or implements T if T is an interface, and returns it as a T.
+The zero value of T is returned on failure
+*/
+func IsType[T any](t testing.TB, value interface{}) T {
+	t.Helper()
+
+	typed, ok := value.(T)
+	if !ok {
+		failf(t, "Expected a value of type %v but got %s", typeOf[T](), describeType(value))
+	}
+
+	return typed
+}
+
+/*
+Asserts that the given value implements the interface I
+*/
+func Implements[I any](t testing.TB, value interface{}) {
+	t.Helper()
+
+	iface := typeOf[I]()
+	if iface.Kind() != reflect.Interface {
+		failf(t, "Implements requires an interface type but got %v", iface)
+		return
+	}
+
+	if value == nil || !reflect.TypeOf(value).Implements(iface) {
+		failf(t, "Expected a value implementing %v but got %s", iface, describeType(value))
+	}
+}
+
+/*
+Asserts that the two given values have the same dynamic type
+*/
+func SameType(t testing.TB, expected interface{}, actual interface{}) {
+	t.Helper()
+
+	if reflect.TypeOf(expected) != reflect.TypeOf(actual) {
+		failf(t, "Expected a value of type %s but got %s", describeType(expected), describeType(actual))
+	}
+}
+
+/*
+Asserts that the given value is of the given kind, e.g. reflect.Slice. A nil value is of kind reflect.Invalid
+*/
+func Kind(t testing.TB, value interface{}, expected reflect.Kind) {
+	t.Helper()
+
+	if kind := reflect.ValueOf(value).Kind(); kind != expected {
+		failf(t, "Expected a value of kind %v but got %v (%s)", expected, kind, describeType(value))
+	}
+}
+
+/*
+Asserts that the given value is assignable to a variable of type T.
+A nil value is assignable to pointers, interfaces, maps, slices, channels and functions
+*/
+func AssignableTo[T any](t testing.TB, value interface{}) {
+	t.Helper()
+
+	target := typeOf[T]()
+	if value == nil {
+		if !isNilable(target.Kind()) {
+			failf(t, "Expected a value assignable to %v but got nil", target)
+		}
+		return
+	}
+
+	if !reflect.TypeOf(value).AssignableTo(target) {
+		failf(t, "Expected a value assignable to %v but got %s", target, describeType(value))
+	}
+}
+
+func describeType(value interface{}) string {
+	if value == nil {
+		return "nil"
+	}
+
+	return reflect.TypeOf(value).String()
+}
+
+func isNilable(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+		return true
+	}
+
+	return false
+}
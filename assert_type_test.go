@@ -0,0 +1,132 @@
+package goassert
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type mockDuration int
+
+func Test_IsTypeShouldReturnTypedValue_GivenValueOfType(t *testing.T) {
+	tester := new(testing.T)
+
+	user := IsType[*mockUser](tester, interface{}(&mockUser{Name: "Ann"}))
+
+	if tester.Failed() || user == nil || user.Name != "Ann" {
+		t.Error("IsType did not return the typed value when the value was of the type")
+	}
+}
+
+func Test_IsTypeShouldReportTypes_GivenValueOfDifferentType(t *testing.T) {
+	tester := newRecordingT()
+
+	IsType[*mockUser](tester, mockUser{})
+
+	if tester.output() != "Expected a value of type *goassert.mockUser but got goassert.mockUser" {
+		t.Errorf("IsType did not report the types but got %q", tester.output())
+	}
+}
+
+func Test_IsTypeShouldPass_GivenValueImplementingInterfaceType(t *testing.T) {
+	tester := new(testing.T)
+
+	IsType[fmt.Stringer](tester, &strings.Builder{})
+
+	if tester.Failed() {
+		t.Error("IsType did not pass when the value implemented the interface type")
+	}
+}
+
+func Test_ImplementsShouldPass_GivenValueImplementingInterface(t *testing.T) {
+	tester := new(testing.T)
+
+	Implements[io.Writer](tester, &strings.Builder{})
+
+	if tester.Failed() {
+		t.Error("Implements did not pass when the value implemented the interface")
+	}
+}
+
+func Test_ImplementsShouldFail_GivenValueNotImplementingInterface(t *testing.T) {
+	tester := newRecordingT()
+
+	Implements[io.Reader](tester, &strings.Builder{})
+
+	if tester.output() != "Expected a value implementing io.Reader but got *strings.Builder" {
+		t.Errorf("Implements did not report the type but got %q", tester.output())
+	}
+}
+
+func Test_ImplementsShouldFail_GivenNonInterfaceType(t *testing.T) {
+	tester := newRecordingT()
+
+	Implements[mockUser](tester, mockUser{})
+
+	if tester.output() != "Implements requires an interface type but got goassert.mockUser" {
+		t.Errorf("Implements did not report the non-interface type but got %q", tester.output())
+	}
+}
+
+func Test_SameTypeShouldPass_GivenValuesOfSameType(t *testing.T) {
+	tester := new(testing.T)
+
+	SameType(tester, 1, 2)
+
+	if tester.Failed() {
+		t.Error("SameType did not pass when the values had the same type")
+	}
+}
+
+func Test_SameTypeShouldFail_GivenValuesOfDifferentTypes(t *testing.T) {
+	tester := newRecordingT()
+
+	SameType(tester, 1, mockDuration(1))
+
+	if tester.output() != "Expected a value of type int but got goassert.mockDuration" {
+		t.Errorf("SameType did not report the types but got %q", tester.output())
+	}
+}
+
+func Test_KindShouldPass_GivenValueOfKind(t *testing.T) {
+	tester := new(testing.T)
+
+	Kind(tester, mockDuration(1), reflect.Int)
+
+	if tester.Failed() {
+		t.Error("Kind did not pass when the value was of the kind")
+	}
+}
+
+func Test_KindShouldFail_GivenValueOfDifferentKind(t *testing.T) {
+	tester := newRecordingT()
+
+	Kind(tester, map[string]int{}, reflect.Slice)
+
+	if tester.output() != "Expected a value of kind slice but got map (map[string]int)" {
+		t.Errorf("Kind did not report the kinds but got %q", tester.output())
+	}
+}
+
+func Test_AssignableToShouldPass_GivenValueAssignableToType(t *testing.T) {
+	tester := new(testing.T)
+
+	AssignableTo[fmt.Stringer](tester, &strings.Builder{})
+	AssignableTo[*mockUser](tester, nil)
+
+	if tester.Failed() {
+		t.Error("AssignableTo did not pass when the value was assignable to the type")
+	}
+}
+
+func Test_AssignableToShouldFail_GivenValueNotAssignableToType(t *testing.T) {
+	tester := new(testing.T)
+
+	AssignableTo[int](tester, mockDuration(1))
+
+	if !tester.Failed() {
+		t.Error("AssignableTo did not fail when the value was not assignable to the type")
+	}
+}
@@ -0,0 +1,57 @@
+package goassert
+
+import (
+	"fmt"
+	"testing"
+)
+
+/*
+Wraps the given test so that every failure reported through it is prefixed with the formatted message.
+Useful to identify the failing row of a table-driven test or the failing iteration of a loop:
+
+	for _, tc := range testCases {
+		goassert.Equal(goassert.With(t, "case %s", tc.name), tc.expected, actual)
+	}
+
+Wrappers can be nested, in which case the prefixes are joined from the outermost to the innermost
+*/
+func With(t testing.TB, format string, args ...interface{}) testing.TB {
+	return withT{
+		TB:     t,
+		prefix: fmt.Sprintf(format, args...),
+	}
+}
+
+type withT struct {
+	testing.TB
+	prefix string
+}
+
+func (w withT) Error(args ...interface{}) {
+	w.TB.Helper()
+	w.report(fmt.Sprint(args...))
+}
+
+func (w withT) Errorf(format string, args ...interface{}) {
+	w.TB.Helper()
+	w.report(fmt.Sprintf(format, args...))
+}
+
+func (w withT) Fatal(args ...interface{}) {
+	w.TB.Helper()
+	w.TB.Fatal(w.prefixed(fmt.Sprint(args...)))
+}
+
+func (w withT) Fatalf(format string, args ...interface{}) {
+	w.TB.Helper()
+	w.TB.Fatal(w.prefixed(fmt.Sprintf(format, args...)))
+}
+
+func (w withT) report(msg string) {
+	w.TB.Helper()
+	fail(w.TB, w.prefixed(msg))
+}
+
+func (w withT) prefixed(msg string) string {
+	return w.prefix + ": " + msg
+}
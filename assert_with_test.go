@@ -0,0 +1,46 @@
+package goassert
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_WithShouldPrefixMessage_WhenAssertionFails(t *testing.T) {
+	tester := newRecordingT()
+
+	Equal(With(tester, "case %s", "negative numbers"), -1, -2)
+
+	if tester.output() != "case negative numbers: Expected: -1. Actual: -2" {
+		t.Errorf("With did not prefix the failure message but got %q", tester.output())
+	}
+}
+
+func Test_WithShouldPrefixFormattedMessage_WhenAssertionFails(t *testing.T) {
+	tester := newRecordingT()
+
+	MapContainsKey(With(tester, "row %d", 3), map[string]int{}, "key")
+
+	if !strings.HasPrefix(tester.output(), "row 3: The given map was expected to contain key key") {
+		t.Errorf("With did not prefix the formatted failure message but got %q", tester.output())
+	}
+}
+
+func Test_WithShouldJoinPrefixes_GivenNestedWrappers(t *testing.T) {
+	tester := newRecordingT()
+
+	True(With(With(tester, "user %s", "ann"), "field %s", "active"), false)
+
+	if !strings.HasPrefix(tester.output(), "user ann: field active: ") {
+		t.Errorf("With did not join the nested prefixes but got %q", tester.output())
+	}
+}
+
+func Test_WithShouldNotFail_WhenAssertionPasses(t *testing.T) {
+	tester := newRecordingT()
+
+	Equal(With(tester, "case %d", 1), 1, 1)
+
+	if tester.Failed() || len(tester.messages) != 0 {
+		t.Error("With reported a failure when the assertion passed")
+	}
+}
@@ -0,0 +1,425 @@
+package goassert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const (
+	diffContextLines = 3
+	maxDiffPaths     = 10
+	maxDiffCells     = 1 << 22
+)
+
+/*
+Walks the two given values side by side and returns the paths of the leaves that differ,
+e.g. ".Users[3].Address.Zip". The root itself is reported as an empty path
+*/
+func diffPaths(expected reflect.Value, actual reflect.Value) []string {
+	differences := diffValues(expected, actual, &equalConfig{})
+
+	paths := make([]string, len(differences))
+	for i, difference := range differences {
+		paths[i] = difference.path
+	}
+
+	return paths
+}
+
+/*
+Walks the two given values side by side, comparing them according to the given config,
+and returns the leaves that differ with their paths
+*/
+func diffValues(expected reflect.Value, actual reflect.Value, config *equalConfig) []difference {
+	walker := &pathWalker{config: config, visited: make(map[[2]uintptr]bool)}
+	walker.walk(expected, actual, "", "")
+
+	return walker.differences
+}
+
+/*
+A leaf that differs between two values. A value missing on one side is invalid
+*/
+type difference struct {
+	path     string
+	expected reflect.Value
+	actual   reflect.Value
+}
+
+type pathWalker struct {
+	config      *equalConfig
+	differences []difference
+	visited     map[[2]uintptr]bool
+}
+
+func (w *pathWalker) differ(expected reflect.Value, actual reflect.Value, path string) {
+	w.differences = append(w.differences, difference{path: path, expected: expected, actual: actual})
+}
+
+/*
+Walks the given values. The path locates the values for display while the field path,
+e.g. "Users.Address", only holds the struct field names leading to them
+*/
+func (w *pathWalker) walk(expected reflect.Value, actual reflect.Value, path string, fieldPath string) {
+	if !expected.IsValid() || !actual.IsValid() {
+		if expected.IsValid() != actual.IsValid() {
+			w.differ(expected, actual, path)
+		}
+		return
+	}
+
+	if expected.Type() != actual.Type() {
+		w.differ(expected, actual, path)
+		return
+	}
+
+	if w.config.ignoreZeroExpected && expected.IsZero() {
+		return
+	}
+
+	if comparer, found := w.config.comparers[expected.Type()]; found && expected.CanInterface() && actual.CanInterface() {
+		if !comparer(expected, actual) {
+			w.differ(expected, actual, path)
+		}
+		return
+	}
+
+	switch expected.Kind() {
+	case reflect.Pointer:
+		if expected.IsNil() || actual.IsNil() {
+			if expected.IsNil() != actual.IsNil() {
+				w.differ(expected, actual, path)
+			}
+			return
+		}
+
+		key := [2]uintptr{expected.Pointer(), actual.Pointer()}
+		if key[0] == key[1] || w.visited[key] {
+			return
+		}
+		w.visited[key] = true
+
+		w.walk(expected.Elem(), actual.Elem(), path, fieldPath)
+	case reflect.Interface:
+		if expected.IsNil() || actual.IsNil() {
+			if expected.IsNil() != actual.IsNil() {
+				w.differ(expected, actual, path)
+			}
+			return
+		}
+		w.walk(expected.Elem(), actual.Elem(), path, fieldPath)
+	case reflect.Struct:
+		for i := 0; i < expected.NumField(); i++ {
+			field := expected.Type().Field(i)
+			if w.config.ignoreUnexported[expected.Type()] && !field.IsExported() {
+				continue
+			}
+
+			childFieldPath := field.Name
+			if fieldPath != "" {
+				childFieldPath = fieldPath + "." + field.Name
+			}
+			if w.config.ignoredFields[childFieldPath] {
+				continue
+			}
+
+			w.walk(expected.Field(i), actual.Field(i), path+"."+field.Name, childFieldPath)
+		}
+	case reflect.Slice:
+		if w.config.equateEmpty && expected.Len() == 0 && actual.Len() == 0 {
+			return
+		}
+		if expected.IsNil() != actual.IsNil() {
+			w.differ(expected, actual, path)
+			return
+		}
+		if sorter, found := w.config.sorters[expected.Type().Elem()]; found && expected.CanInterface() && actual.CanInterface() {
+			expected, actual = sorter(expected), sorter(actual)
+		}
+		w.walkElements(expected, actual, path, fieldPath)
+	case reflect.Array:
+		w.walkElements(expected, actual, path, fieldPath)
+	case reflect.Map:
+		if w.config.equateEmpty && expected.Len() == 0 && actual.Len() == 0 {
+			return
+		}
+		w.walkMap(expected, actual, path, fieldPath)
+	case reflect.Func:
+		if !expected.IsNil() || !actual.IsNil() {
+			w.differ(expected, actual, path)
+		}
+	case reflect.Float32, reflect.Float64:
+		if w.config.approxFloats && withinDelta(expected.Float(), actual.Float(), w.config.floatDelta) {
+			return
+		}
+		if !equalLeaves(expected, actual) {
+			w.differ(expected, actual, path)
+		}
+	default:
+		if !equalLeaves(expected, actual) {
+			w.differ(expected, actual, path)
+		}
+	}
+}
+
+func (w *pathWalker) walkElements(expected reflect.Value, actual reflect.Value, path string, fieldPath string) {
+	expectedLength := expected.Len()
+	actualLength := actual.Len()
+
+	for i := 0; i < expectedLength || i < actualLength; i++ {
+		elementPath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i >= actualLength:
+			w.differ(expected.Index(i), reflect.Value{}, elementPath)
+		case i >= expectedLength:
+			w.differ(reflect.Value{}, actual.Index(i), elementPath)
+		default:
+			w.walk(expected.Index(i), actual.Index(i), elementPath, fieldPath)
+		}
+	}
+}
+
+func (w *pathWalker) walkMap(expected reflect.Value, actual reflect.Value, path string, fieldPath string) {
+	if expected.IsNil() != actual.IsNil() {
+		w.differ(expected, actual, path)
+		return
+	}
+
+	for _, key := range sortedMapKeys(expected) {
+		keyPath := fmt.Sprintf("%s[%s]", path, prettyPrintValue(key, true))
+		actualValue := actual.MapIndex(key)
+		if !actualValue.IsValid() {
+			w.differ(expected.MapIndex(key), actualValue, keyPath)
+			continue
+		}
+		w.walk(expected.MapIndex(key), actualValue, keyPath, fieldPath)
+	}
+
+	for _, key := range sortedMapKeys(actual) {
+		if !expected.MapIndex(key).IsValid() {
+			w.differ(reflect.Value{}, actual.MapIndex(key), fmt.Sprintf("%s[%s]", path, prettyPrintValue(key, true)))
+		}
+	}
+}
+
+func equalLeaves(expected reflect.Value, actual reflect.Value) bool {
+	switch expected.Kind() {
+	case reflect.Bool:
+		return expected.Bool() == actual.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return expected.Int() == actual.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return expected.Uint() == actual.Uint()
+	case reflect.Float32, reflect.Float64:
+		return expected.Float() == actual.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return expected.Complex() == actual.Complex()
+	case reflect.String:
+		return expected.String() == actual.String()
+	case reflect.Chan, reflect.UnsafePointer:
+		return expected.Pointer() == actual.Pointer()
+	}
+
+	return false
+}
+
+func formatDiffPaths(paths []string) string {
+	var b strings.Builder
+	for i, path := range paths {
+		if i == maxDiffPaths {
+			fmt.Fprintf(&b, "\t... and %d more\n", len(paths)-maxDiffPaths)
+			break
+		}
+		if path == "" {
+			path = "(root)"
+		}
+		fmt.Fprintf(&b, "\t%s\n", path)
+	}
+
+	return b.String()
+}
+
+type diffOp struct {
+	kind byte
+	line string
+}
+
+/*
+Computes a line based diff between the two given texts and renders it in unified format
+with "-" for lines only in expected and "+" for lines only in actual
+*/
+func unifiedDiff(expected string, actual string) string {
+	return unifiedLineDiff(strings.Split(expected, "\n"), strings.Split(actual, "\n"))
+}
+
+func unifiedLineDiff(expectedLines []string, actualLines []string) string {
+	ops := diffLines(expectedLines, actualLines)
+
+	var b strings.Builder
+	b.WriteString("--- expected\n+++ actual\n")
+
+	expectedLineNumbers := make([]int, len(ops)+1)
+	actualLineNumbers := make([]int, len(ops)+1)
+	for i, op := range ops {
+		expectedLineNumbers[i+1] = expectedLineNumbers[i]
+		actualLineNumbers[i+1] = actualLineNumbers[i]
+		if op.kind != '+' {
+			expectedLineNumbers[i+1]++
+		}
+		if op.kind != '-' {
+			actualLineNumbers[i+1]++
+		}
+	}
+
+	i := 0
+	for i < len(ops) {
+		for i < len(ops) && ops[i].kind == ' ' {
+			i++
+		}
+		if i == len(ops) {
+			break
+		}
+
+		start := i - diffContextLines
+		if start < 0 {
+			start = 0
+		}
+		end := hunkEnd(ops, i)
+
+		fmt.Fprintf(&b, "@@ -%s +%s @@\n",
+			hunkRange(expectedLineNumbers[start], expectedLineNumbers[end]),
+			hunkRange(actualLineNumbers[start], actualLineNumbers[end]))
+		for _, op := range ops[start:end] {
+			b.WriteByte(op.kind)
+			b.WriteString(op.line)
+			b.WriteByte('\n')
+		}
+
+		i = end
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func hunkEnd(ops []diffOp, changeStart int) int {
+	end := changeStart
+	for end < len(ops) {
+		if ops[end].kind != ' ' {
+			end++
+			continue
+		}
+
+		runEnd := end
+		for runEnd < len(ops) && ops[runEnd].kind == ' ' {
+			runEnd++
+		}
+
+		if runEnd == len(ops) || runEnd-end > 2*diffContextLines {
+			end += diffContextLines
+			if end > len(ops) {
+				end = len(ops)
+			}
+			return end
+		}
+
+		end = runEnd
+	}
+
+	return end
+}
+
+func hunkRange(startLine int, endLine int) string {
+	count := endLine - startLine
+	if count == 0 {
+		return fmt.Sprintf("%d,0", startLine)
+	}
+
+	return fmt.Sprintf("%d,%d", startLine+1, count)
+}
+
+/*
+Computes the edit script between the two given line slices using the longest common subsequence.
+Inputs too large for the quadratic table are reported as a full replacement of their differing middle part
+*/
+func diffLines(expected []string, actual []string) []diffOp {
+	prefix := 0
+	for prefix < len(expected) && prefix < len(actual) && expected[prefix] == actual[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(expected)-prefix && suffix < len(actual)-prefix &&
+		expected[len(expected)-1-suffix] == actual[len(actual)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]diffOp, 0, len(expected)+len(actual))
+	for _, line := range expected[:prefix] {
+		ops = append(ops, diffOp{kind: ' ', line: line})
+	}
+
+	ops = append(ops, diffMiddle(expected[prefix:len(expected)-suffix], actual[prefix:len(actual)-suffix])...)
+
+	for _, line := range expected[len(expected)-suffix:] {
+		ops = append(ops, diffOp{kind: ' ', line: line})
+	}
+
+	return ops
+}
+
+func diffMiddle(expected []string, actual []string) []diffOp {
+	n, m := len(expected), len(actual)
+	ops := make([]diffOp, 0, n+m)
+
+	if n*m > maxDiffCells {
+		for _, line := range expected {
+			ops = append(ops, diffOp{kind: '-', line: line})
+		}
+		for _, line := range actual {
+			ops = append(ops, diffOp{kind: '+', line: line})
+		}
+		return ops
+	}
+
+	// lcs[i][j] holds the length of the longest common subsequence of expected[i:] and actual[j:]
+	lcs := make([][]int32, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if expected[i] == actual[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case expected[i] == actual[j]:
+			ops = append(ops, diffOp{kind: ' ', line: expected[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{kind: '-', line: expected[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{kind: '+', line: actual[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		ops = append(ops, diffOp{kind: '-', line: expected[i]})
+	}
+	for ; j < m; j++ {
+		ops = append(ops, diffOp{kind: '+', line: actual[j]})
+	}
+
+	return ops
+}
@@ -0,0 +1,261 @@
+package goassert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+/*
+Formats the given value as deterministic, Go-like source. Composite values are spread over
+multiple lines so that two renderings can be compared line by line. Map entries are sorted
+*/
+func prettyPrint(value interface{}) string {
+	return prettyPrintValue(reflect.ValueOf(value), false)
+}
+
+func prettyPrintValue(value reflect.Value, compact bool) string {
+	printer := &prettyPrinter{
+		compact: compact,
+		visited: make(map[uintptr]bool),
+	}
+	printer.print(value, 0)
+
+	return printer.buf.String()
+}
+
+type prettyPrinter struct {
+	buf     strings.Builder
+	compact bool
+	visited map[uintptr]bool
+}
+
+func (p *prettyPrinter) print(v reflect.Value, depth int) {
+	if !v.IsValid() {
+		p.buf.WriteString("nil")
+		return
+	}
+
+	if v.Type() == timeType && v.CanInterface() {
+		fmt.Fprintf(&p.buf, "time.Time(%s)", v.Interface().(time.Time).Format(time.RFC3339Nano))
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		p.buf.WriteString(strconv.FormatBool(v.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		p.buf.WriteString(strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		p.buf.WriteString(strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		p.buf.WriteString(strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()))
+	case reflect.Complex64, reflect.Complex128:
+		p.buf.WriteString(strconv.FormatComplex(v.Complex(), 'g', -1, v.Type().Bits()))
+	case reflect.String:
+		p.buf.WriteString(strconv.Quote(v.String()))
+	case reflect.Interface:
+		if v.IsNil() {
+			p.buf.WriteString("nil")
+			return
+		}
+		p.print(v.Elem(), depth)
+	case reflect.Pointer:
+		p.printPointer(v, depth)
+	case reflect.Struct:
+		p.printStruct(v, depth)
+	case reflect.Slice:
+		if v.IsNil() {
+			fmt.Fprintf(&p.buf, "%s(nil)", v.Type())
+			return
+		}
+		p.printElements(v, depth)
+	case reflect.Array:
+		p.printElements(v, depth)
+	case reflect.Map:
+		p.printMap(v, depth)
+	default:
+		if v.IsNil() {
+			fmt.Fprintf(&p.buf, "(%s)(nil)", v.Type())
+			return
+		}
+		fmt.Fprintf(&p.buf, "(%s)(%#x)", v.Type(), v.Pointer())
+	}
+}
+
+func (p *prettyPrinter) printPointer(v reflect.Value, depth int) {
+	if v.IsNil() {
+		p.buf.WriteString("nil")
+		return
+	}
+
+	address := v.Pointer()
+	if p.visited[address] {
+		fmt.Fprintf(&p.buf, "<cycle %s>", v.Type())
+		return
+	}
+
+	p.visited[address] = true
+	defer delete(p.visited, address)
+
+	p.buf.WriteString("&")
+	p.print(v.Elem(), depth)
+}
+
+func (p *prettyPrinter) printStruct(v reflect.Value, depth int) {
+	p.buf.WriteString(v.Type().String())
+	p.buf.WriteString("{")
+
+	fieldCount := v.NumField()
+	for i := 0; i < fieldCount; i++ {
+		p.beginEntry(i, depth+1)
+		p.buf.WriteString(v.Type().Field(i).Name)
+		p.buf.WriteString(": ")
+		p.print(v.Field(i), depth+1)
+		p.endEntry()
+	}
+
+	p.closeComposite(fieldCount, depth)
+}
+
+func (p *prettyPrinter) printElements(v reflect.Value, depth int) {
+	p.buf.WriteString(v.Type().String())
+	p.buf.WriteString("{")
+
+	length := v.Len()
+	for i := 0; i < length; i++ {
+		p.beginEntry(i, depth+1)
+		p.print(v.Index(i), depth+1)
+		p.endEntry()
+	}
+
+	p.closeComposite(length, depth)
+}
+
+func (p *prettyPrinter) printMap(v reflect.Value, depth int) {
+	if v.IsNil() {
+		fmt.Fprintf(&p.buf, "%s(nil)", v.Type())
+		return
+	}
+
+	p.buf.WriteString(v.Type().String())
+	p.buf.WriteString("{")
+
+	keys := sortedMapKeys(v)
+	for i, key := range keys {
+		p.beginEntry(i, depth+1)
+		p.buf.WriteString(prettyPrintValue(key, true))
+		p.buf.WriteString(": ")
+		p.print(v.MapIndex(key), depth+1)
+		p.endEntry()
+	}
+
+	p.closeComposite(len(keys), depth)
+}
+
+func (p *prettyPrinter) beginEntry(index int, depth int) {
+	if p.compact {
+		if index > 0 {
+			p.buf.WriteString(", ")
+		}
+		return
+	}
+
+	p.buf.WriteString("\n")
+	p.buf.WriteString(strings.Repeat("\t", depth))
+}
+
+func (p *prettyPrinter) endEntry() {
+	if !p.compact {
+		p.buf.WriteString(",")
+	}
+}
+
+func (p *prettyPrinter) closeComposite(entryCount int, depth int) {
+	if !p.compact && entryCount > 0 {
+		p.buf.WriteString("\n")
+		p.buf.WriteString(strings.Repeat("\t", depth))
+	}
+	p.buf.WriteString("}")
+}
+
+func sortedMapKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	formattedKeys := make([]string, len(keys))
+	for i, key := range keys {
+		formattedKeys[i] = prettyPrintValue(key, true)
+	}
+
+	sort.Sort(mapKeySorter{keys: keys, formattedKeys: formattedKeys})
+
+	return keys
+}
+
+/*
+Returns the keys of the given map in the order of [sortedMapKeys]. The keys are collected by ranging over the map,
+so that keys such as nil interfaces do not have to be converted back from a reflect.Value
+*/
+func sortedKeys[K comparable, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	copies := append([]K(nil), keys...)
+	sorter := typedKeySorter[K]{
+		mapKeySorter: mapKeySorter{keys: make([]reflect.Value, len(keys)), formattedKeys: make([]string, len(keys))},
+		typedKeys:    keys,
+	}
+	for i := range copies {
+		sorter.keys[i] = reflect.ValueOf(&copies[i]).Elem()
+		sorter.formattedKeys[i] = prettyPrintValue(sorter.keys[i], true)
+	}
+	sort.Sort(sorter)
+
+	return keys
+}
+
+type typedKeySorter[K any] struct {
+	mapKeySorter
+	typedKeys []K
+}
+
+func (s typedKeySorter[K]) Swap(i, j int) {
+	s.mapKeySorter.Swap(i, j)
+	s.typedKeys[i], s.typedKeys[j] = s.typedKeys[j], s.typedKeys[i]
+}
+
+type mapKeySorter struct {
+	keys          []reflect.Value
+	formattedKeys []string
+}
+
+func (s mapKeySorter) Len() int {
+	return len(s.keys)
+}
+
+func (s mapKeySorter) Less(i, j int) bool {
+	a, b := s.keys[i], s.keys[j]
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float()
+	case reflect.String:
+		return a.String() < b.String()
+	}
+
+	return s.formattedKeys[i] < s.formattedKeys[j]
+}
+
+func (s mapKeySorter) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	s.formattedKeys[i], s.formattedKeys[j] = s.formattedKeys[j], s.formattedKeys[i]
+}
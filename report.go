@@ -0,0 +1,115 @@
+package goassert
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+/*
+Implemented by the test wrappers of this package that handle failure messages themselves,
+e.g. to collect them instead of reporting them to the test right away
+*/
+type reporter interface {
+	report(msg string)
+}
+
+/*
+Reports the given failure message through the given test. Every assertion reports its failures through here
+*/
+func fail(t testing.TB, msg string) {
+	t.Helper()
+
+	if r, ok := t.(reporter); ok {
+		r.report(msg)
+		return
+	}
+
+	t.Error(msg)
+}
+
+func failf(t testing.TB, format string, args ...interface{}) {
+	t.Helper()
+	fail(t, fmt.Sprintf(format, args...))
+}
+
+/*
+A testing.TB that records failures instead of reporting them to the test. Shared by [Asserter] and [CollectT].
+FailNow, Fatal and Fatalf stop the function started by run without stopping the test
+*/
+type failureCollector struct {
+	testing.TB
+
+	mu       sync.Mutex
+	failed   bool
+	failures []string
+}
+
+func (c *failureCollector) Error(args ...interface{}) {
+	c.report(fmt.Sprint(args...))
+}
+
+func (c *failureCollector) Errorf(format string, args ...interface{}) {
+	c.report(fmt.Sprintf(format, args...))
+}
+
+func (c *failureCollector) Fatal(args ...interface{}) {
+	c.report(fmt.Sprint(args...))
+	c.FailNow()
+}
+
+func (c *failureCollector) Fatalf(format string, args ...interface{}) {
+	c.report(fmt.Sprintf(format, args...))
+	c.FailNow()
+}
+
+func (c *failureCollector) Fail() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.failed = true
+}
+
+func (c *failureCollector) FailNow() {
+	c.Fail()
+	runtime.Goexit()
+}
+
+func (c *failureCollector) Failed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.failed
+}
+
+func (c *failureCollector) report(msg string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.failed = true
+	c.failures = append(c.failures, msg)
+}
+
+/*
+Runs the given function on its own goroutine so that FailNow stops the function without stopping the test.
+A panic in the function is recorded as a failure with its stack, since it would otherwise crash the test binary
+*/
+func (c *failureCollector) run(f func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		if p := capturePanic(f); p.panicked() {
+			c.report(p.msg(fmt.Sprintf("Unexpected panic: %v", p.recovered)))
+		}
+	}()
+	<-done
+}
+
+func (c *failureCollector) collectedFailures() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]string(nil), c.failures...)
+}
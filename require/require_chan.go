@@ -0,0 +1,64 @@
+package require
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golanglibs/goassert"
+)
+
+/*
+Requires that a value is received from the given channel within the given timeout and returns it
+*/
+func ChanReceives[T any](t testing.TB, ch <-chan T, timeout time.Duration) T {
+	t.Helper()
+	return goassert.ChanReceives(fatal(t), ch, timeout)
+}
+
+/*
+Requires that the expected value is the next value received from the given channel within the given timeout
+*/
+func ChanReceivesValue[T comparable](t testing.TB, ch <-chan T, expected T, timeout time.Duration) {
+	t.Helper()
+	goassert.ChanReceivesValue(fatal(t), ch, expected, timeout)
+}
+
+/*
+Requires that the given channel is closed
+*/
+func ChanClosed[T any](t testing.TB, ch <-chan T) {
+	t.Helper()
+	goassert.ChanClosed(fatal(t), ch)
+}
+
+/*
+Requires that the given channel is not closed
+*/
+func ChanNotClosed[T any](t testing.TB, ch <-chan T) {
+	t.Helper()
+	goassert.ChanNotClosed(fatal(t), ch)
+}
+
+/*
+Requires that the given channel holds no buffered values
+*/
+func ChanEmpty[T any](t testing.TB, ch <-chan T) {
+	t.Helper()
+	goassert.ChanEmpty(fatal(t), ch)
+}
+
+/*
+Requires that the given channel holds the expected number of buffered values
+*/
+func ChanLength[T any](t testing.TB, ch <-chan T, expectedLength int) {
+	t.Helper()
+	goassert.ChanLength(fatal(t), ch, expectedLength)
+}
+
+/*
+Requires that no value is received from the given channel during the given duration
+*/
+func ChanNeverReceives[T any](t testing.TB, ch <-chan T, duration time.Duration) {
+	t.Helper()
+	goassert.ChanNeverReceives(fatal(t), ch, duration)
+}
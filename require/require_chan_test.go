@@ -0,0 +1,39 @@
+package require
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_ChanReceivesShouldContinue_WhenValueIsReceived(t *testing.T) {
+	ch := make(chan int, 1)
+	ch <- 1
+
+	tester, completed := runRequirement(func(t testing.TB) {
+		ChanReceives(t, ch, time.Second)
+	})
+
+	if tester.Failed() || !completed {
+		t.Error("ChanReceives did not continue when a value was received")
+	}
+}
+
+func Test_ChanReceivesShouldStopTest_WhenNothingIsReceived(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		ChanReceives(t, make(chan int), 10*time.Millisecond)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("ChanReceives did not stop the test when nothing was received")
+	}
+}
+
+func Test_ChanClosedShouldStopTest_GivenOpenChannel(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		ChanClosed(t, make(chan int))
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("ChanClosed did not stop the test when the channel was open")
+	}
+}
@@ -0,0 +1,143 @@
+package require
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+/*
+Requires that the two given values are equal. The given values must be [comparable]
+*/
+func Equal[K comparable](t testing.TB, expected K, actual K) {
+	t.Helper()
+	goassert.Equal(fatal(t), expected, actual)
+}
+
+/*
+Requires that the two given values are not equal. The given values must be [comparable]
+*/
+func NotEqual[K comparable](t testing.TB, expected K, actual K) {
+	t.Helper()
+	goassert.NotEqual(fatal(t), expected, actual)
+}
+
+/*
+Requires that the two given values are deeply equal. Internally uses reflect.DeepEqual
+*/
+func DeepEqual[T any](t testing.TB, expected T, actual T) {
+	t.Helper()
+	goassert.DeepEqual(fatal(t), expected, actual)
+}
+
+/*
+Requires that the two given values are not deeply equal. Internally uses reflect.DeepEqual
+*/
+func NotDeepEqual[T any](t testing.TB, expected T, actual T) {
+	t.Helper()
+	goassert.NotDeepEqual(fatal(t), expected, actual)
+}
+
+/*
+Requires that the two given values are deeply equal according to the given options
+*/
+func DeepEqualWith[T any](t testing.TB, expected T, actual T, options ...goassert.EqualOption) {
+	t.Helper()
+	goassert.DeepEqualWith(fatal(t), expected, actual, options...)
+}
+
+/*
+Requires that the given value is the zero value of its type
+*/
+func Zero[T any](t testing.TB, actual T) {
+	t.Helper()
+	goassert.Zero(fatal(t), actual)
+}
+
+/*
+Requires that the given value is not the zero value of its type
+*/
+func NotZero[T any](t testing.TB, actual T) {
+	t.Helper()
+	goassert.NotZero(fatal(t), actual)
+}
+
+/*
+Requires that the given value is nil
+*/
+func Nil(t testing.TB, actual interface{}) {
+	t.Helper()
+	goassert.Nil(fatal(t), actual)
+}
+
+/*
+Requires that the given value is not nil
+*/
+func NotNil(t testing.TB, actual interface{}) {
+	t.Helper()
+	goassert.NotNil(fatal(t), actual)
+}
+
+/*
+Requires that the two given slices have the same values in any order. Values are compared with reflect.DeepEqual
+*/
+func SimilarSlice[T any](t testing.TB, expected []T, actual []T) {
+	t.Helper()
+	goassert.SimilarSlice(fatal(t), expected, actual)
+}
+
+/*
+Requires that the two given slices do not have the same values
+*/
+func NotSimilarSlice[T any](t testing.TB, expected []T, actual []T) {
+	t.Helper()
+	goassert.NotSimilarSlice(fatal(t), expected, actual)
+}
+
+/*
+Requires that the given error is nil
+*/
+func NoError(t testing.TB, err error) {
+	t.Helper()
+	goassert.NoError(fatal(t), err)
+}
+
+/*
+Requires that the given error is not nil
+*/
+func Error(t testing.TB, err error) {
+	t.Helper()
+	goassert.Error(fatal(t), err)
+}
+
+/*
+Requires that the given error or any error in its chain matches the target error. Internally uses errors.Is
+*/
+func ErrorIs(t testing.TB, err error, target error) {
+	t.Helper()
+	goassert.ErrorIs(fatal(t), err, target)
+}
+
+/*
+Requires that the given error or any error in its chain can be assigned to type T and returns the matching error
+*/
+func ErrorAs[T any](t testing.TB, err error) T {
+	t.Helper()
+	return goassert.ErrorAs[T](fatal(t), err)
+}
+
+/*
+Requires that the message of the given error contains the given substring
+*/
+func ErrorContains(t testing.TB, err error, substring string) {
+	t.Helper()
+	goassert.ErrorContains(fatal(t), err, substring)
+}
+
+/*
+Requires that the message of the given error equals the given message
+*/
+func ErrorMessage(t testing.TB, err error, expectedMessage string) {
+	t.Helper()
+	goassert.ErrorMessage(fatal(t), err, expectedMessage)
+}
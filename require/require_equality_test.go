@@ -0,0 +1,210 @@
+package require
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+func Test_EqualShouldContinue_WhenActualMatchesExpected(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		Equal(t, "expected value", "expected value")
+	})
+
+	if tester.Failed() || !completed {
+		t.Error("Equal did not continue when actual matched expected")
+	}
+}
+
+func Test_EqualShouldStopTest_WhenActualDoesNotMatchExpected(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		Equal(t, "expected value", "actual value")
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("Equal did not stop the test when actual did not match expected")
+	}
+}
+
+func Test_NotEqualShouldStopTest_WhenActualMatchesExpected(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		NotEqual(t, 10, 10)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("NotEqual did not stop the test when actual matched expected")
+	}
+}
+
+func Test_DeepEqualShouldStopTest_WhenActualDoesNotMatchExpected(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		DeepEqual(t, []int{3, 10}, []int{3, 16})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("DeepEqual did not stop the test when actual did not match expected")
+	}
+}
+
+func Test_NotDeepEqualShouldStopTest_WhenActualMatchesExpected(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		NotDeepEqual(t, []int{3, 10}, []int{3, 10})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("NotDeepEqual did not stop the test when actual matched expected")
+	}
+}
+
+func Test_NilShouldStopTest_GivenNonNilValue(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		Nil(t, []int{})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("Nil did not stop the test when non-nil value was given")
+	}
+}
+
+func Test_NotNilShouldContinue_GivenNonNilValue(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		NotNil(t, []int{})
+	})
+
+	if tester.Failed() || !completed {
+		t.Error("NotNil did not continue when non-nil value was given")
+	}
+}
+
+func Test_NotNilShouldStopTest_GivenNilValue(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		var nilSlice []int
+		NotNil(t, nilSlice)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("NotNil did not stop the test when nil value was given")
+	}
+}
+
+func Test_SimilarSliceShouldStopTest_WhenActualSliceDoesNotMatchExpected(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		SimilarSlice(t, []int{3, 10}, []int{10, 5})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("SimilarSlice did not stop the test when actual slice did not match expected")
+	}
+}
+
+func Test_NotSimilarSliceShouldStopTest_WhenActualSliceMatchesExpected(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		NotSimilarSlice(t, []int{3, 10}, []int{10, 3})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("NotSimilarSlice did not stop the test when actual slice matched expected")
+	}
+}
+
+func Test_NoErrorShouldStopTest_GivenNonNilError(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		NoError(t, errors.New("Error"))
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("NoError did not stop the test when non-nil error was given")
+	}
+}
+
+func Test_ErrorShouldStopTest_GivenNilError(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		Error(t, nil)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("Error did not stop the test when nil error was given")
+	}
+}
+
+func Test_ErrorIsShouldStopTest_WhenErrorChainDoesNotContainTarget(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		ErrorIs(t, errors.New("other"), errors.New("target"))
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("ErrorIs did not stop the test when the error chain did not contain the target")
+	}
+}
+
+func Test_ErrorAsShouldStopTest_WhenErrorChainDoesNotContainType(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		ErrorAs[*fs.PathError](t, errors.New("other"))
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("ErrorAs did not stop the test when the error chain did not contain the given type")
+	}
+}
+
+func Test_ErrorContainsShouldStopTest_WhenErrorMessageDoesNotContainSubstring(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		ErrorContains(t, errors.New("file not found"), "permission denied")
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("ErrorContains did not stop the test when the error message did not contain the substring")
+	}
+}
+
+func Test_ErrorMessageShouldStopTest_WhenErrorMessageDoesNotMatch(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		ErrorMessage(t, errors.New("file not found"), "not found")
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("ErrorMessage did not stop the test when the error message did not match")
+	}
+}
+
+func Test_EqualShouldStopTest_GivenTestWrappedWithMessage(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		Equal(goassert.With(t, "case %d", 1), "expected value", "actual value")
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("Equal did not stop the test when given a test wrapped with a message")
+	}
+}
+
+func Test_DeepEqualWithShouldContinue_GivenValuesEqualWithOptions(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		DeepEqualWith(t, []int{}, nil, goassert.EquateEmpty())
+	})
+
+	if tester.Failed() || !completed {
+		t.Error("DeepEqualWith did not continue when the values were equal with the options")
+	}
+}
+
+func Test_DeepEqualWithShouldStopTest_GivenDifferentValues(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		DeepEqualWith(t, []int{1}, []int{2})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("DeepEqualWith did not stop the test when the values were different")
+	}
+}
+
+func Test_NotZeroShouldStopTest_GivenZeroValue(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		NotZero(t, struct{ Name string }{})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("NotZero did not stop the test when given a zero value")
+	}
+}
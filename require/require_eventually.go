@@ -0,0 +1,40 @@
+package require
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golanglibs/goassert"
+)
+
+/*
+Requires that the given condition returns true within the given timeout
+*/
+func Eventually(t testing.TB, condition func() bool, timeout time.Duration, tick time.Duration) {
+	t.Helper()
+	goassert.Eventually(fatal(t), condition, timeout, tick)
+}
+
+/*
+Requires that the given condition never returns true during the given duration
+*/
+func Never(t testing.TB, condition func() bool, duration time.Duration, tick time.Duration) {
+	t.Helper()
+	goassert.Never(fatal(t), condition, duration, tick)
+}
+
+/*
+Requires that the given condition returns true every time it is checked during the given duration
+*/
+func Consistently(t testing.TB, condition func() bool, duration time.Duration, tick time.Duration) {
+	t.Helper()
+	goassert.Consistently(fatal(t), condition, duration, tick)
+}
+
+/*
+Requires that the assertions run by the given function pass within the given timeout
+*/
+func EventuallyWith(t testing.TB, assertions func(c *goassert.CollectT), timeout time.Duration, tick time.Duration) {
+	t.Helper()
+	goassert.EventuallyWith(fatal(t), assertions, timeout, tick)
+}
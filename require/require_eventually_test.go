@@ -0,0 +1,74 @@
+package require
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golanglibs/goassert"
+)
+
+func Test_EventuallyShouldContinue_WhenConditionIsSatisfiedBeforeTimeout(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		Eventually(t, func() bool { return true }, time.Second, time.Millisecond)
+	})
+
+	if tester.Failed() || !completed {
+		t.Error("Eventually did not continue when the condition was satisfied before the timeout")
+	}
+}
+
+func Test_EventuallyShouldStopTest_WhenConditionIsNotSatisfiedBeforeTimeout(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		Eventually(t, func() bool { return false }, 10*time.Millisecond, time.Millisecond)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("Eventually did not stop the test when the condition was not satisfied before the timeout")
+	}
+}
+
+func Test_NeverShouldStopTest_WhenConditionIsSatisfied(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		Never(t, func() bool { return true }, 10*time.Millisecond, time.Millisecond)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("Never did not stop the test when the condition was satisfied")
+	}
+}
+
+func Test_ConsistentlyShouldStopTest_WhenConditionIsNotSatisfied(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		Consistently(t, func() bool { return false }, 10*time.Millisecond, time.Millisecond)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("Consistently did not stop the test when the condition was not satisfied")
+	}
+}
+
+func Test_EventuallyWithShouldStopTest_WhenAssertionsDoNotPassBeforeTimeout(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		EventuallyWith(t, func(c *goassert.CollectT) {
+			goassert.True(c, false)
+		}, 10*time.Millisecond, time.Millisecond)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("EventuallyWith did not stop the test when the assertions did not pass before the timeout")
+	}
+}
+
+func Test_EventuallyWithShouldRetry_WhenRequirementStopsAnAttempt(t *testing.T) {
+	attempts := 0
+	tester, completed := runRequirement(func(t testing.TB) {
+		EventuallyWith(t, func(c *goassert.CollectT) {
+			attempts++
+			Equal(c, 3, attempts)
+		}, time.Second, time.Millisecond)
+	})
+
+	if tester.Failed() || !completed || attempts != 3 {
+		t.Errorf("EventuallyWith did not retry after a requirement stopped an attempt, attempts: %d", attempts)
+	}
+}
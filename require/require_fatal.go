@@ -0,0 +1,19 @@
+/*
+Package require mirrors the assertions of goassert but stops the test on failure.
+Every function delegates to its goassert counterpart, reporting failures through
+t.Fatal instead of t.Error, so the two families share the same failure logic and messages
+*/
+package require
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+/*
+Wraps the given test so that failures stop it. Shares the implementation of goassert's Assertions.Require
+*/
+func fatal(t testing.TB) testing.TB {
+	return goassert.New(t).Require().T()
+}
@@ -0,0 +1,14 @@
+package require
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+/*
+Returns assertions bound to the given test that stop the test on failure, see goassert.New
+*/
+func New(t testing.TB) *goassert.Assertions {
+	return goassert.New(fatal(t))
+}
@@ -0,0 +1,29 @@
+package require
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+func Test_NewShouldContinue_WhenAssertionsPass(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		a := New(t)
+		a.True(true)
+		goassert.ThatComparableSlice(a, []int{1, 2}).Contains(2)
+	})
+
+	if tester.Failed() || !completed {
+		t.Error("New did not continue when the assertions passed")
+	}
+}
+
+func Test_NewShouldStopTest_WhenAssertionFails(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		goassert.ThatComparableSlice(New(t), []int{1, 2}).Contains(3)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("New did not stop the test when the assertion failed")
+	}
+}
@@ -0,0 +1,23 @@
+package require
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+/*
+Requires that the content of the golden file at the given path equals the given bytes
+*/
+func EqualGoldenFile(t testing.TB, path string, actual []byte) {
+	t.Helper()
+	goassert.EqualGoldenFile(fatal(t), path, actual)
+}
+
+/*
+Requires that the given value matches the snapshot of the current test, stored in testdata/snapshots and named after t.Name()
+*/
+func MatchSnapshot(t testing.TB, value interface{}) {
+	t.Helper()
+	goassert.MatchSnapshot(fatal(t), value)
+}
@@ -0,0 +1,19 @@
+package require
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func Test_EqualGoldenFileShouldStopTest_WhenGoldenFileDoesNotExist(t *testing.T) {
+	t.Setenv("GOASSERT_UPDATE", "false")
+	path := filepath.Join(t.TempDir(), "missing.golden")
+
+	tester, completed := runRequirement(func(t testing.TB) {
+		EqualGoldenFile(t, path, []byte("content"))
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("EqualGoldenFile did not stop the test when the golden file did not exist")
+	}
+}
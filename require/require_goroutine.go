@@ -0,0 +1,15 @@
+package require
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+/*
+Snapshots the running goroutines and registers a cleanup requiring that no goroutine started since then is still running
+*/
+func NoGoroutineLeaks(t testing.TB, options ...goassert.GoroutineLeakOption) {
+	t.Helper()
+	goassert.NoGoroutineLeaks(fatal(t), options...)
+}
@@ -0,0 +1,24 @@
+package require
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golanglibs/goassert"
+)
+
+func Test_NoGoroutineLeaksShouldStopTest_WhenStartedGoroutineIsStillRunning(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	tester, completed := runRequirementCleanups(func(t testing.TB) {
+		NoGoroutineLeaks(t, goassert.GoroutineLeakGracePeriod(10*time.Millisecond))
+		go func() {
+			<-release
+		}()
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("NoGoroutineLeaks did not stop the test when the started goroutine was still running")
+	}
+}
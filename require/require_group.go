@@ -0,0 +1,16 @@
+package require
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+/*
+Requires that all the assertions run by the given function pass.
+Every assertion runs to completion and the failures are reported as a single numbered block before the test is stopped
+*/
+func Group(t testing.TB, name string, assertions func(a *goassert.Asserter)) {
+	t.Helper()
+	goassert.Group(fatal(t), name, assertions)
+}
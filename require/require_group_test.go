@@ -0,0 +1,35 @@
+package require
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+func Test_GroupShouldContinue_WhenAllAssertionsPass(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		Group(t, "numbers", func(a *goassert.Asserter) {
+			goassert.Equal(a, 1, 1)
+		})
+	})
+
+	if tester.Failed() || !completed {
+		t.Error("Group did not continue when all assertions passed")
+	}
+}
+
+func Test_GroupShouldRunAllAssertionsAndStopTest_WhenAssertionsFail(t *testing.T) {
+	assertionsRun := 0
+	tester, completed := runRequirement(func(t testing.TB) {
+		Group(t, "numbers", func(a *goassert.Asserter) {
+			goassert.Equal(a, 1, 2)
+			assertionsRun++
+			goassert.Equal(a, 3, 4)
+			assertionsRun++
+		})
+	})
+
+	if !tester.Failed() || completed || assertionsRun != 2 {
+		t.Error("Group did not run all assertions and stop the test when assertions failed")
+	}
+}
@@ -0,0 +1,65 @@
+package require
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+/*
+Requires that the given response has the expected status code
+*/
+func StatusCode(t testing.TB, response *http.Response, expected int) {
+	t.Helper()
+	goassert.StatusCode(fatal(t), response, expected)
+}
+
+/*
+Requires that the first value of the given header of the given response equals the expected value
+*/
+func HeaderEqual(t testing.TB, response *http.Response, name string, expected string) {
+	t.Helper()
+	goassert.HeaderEqual(fatal(t), response, name, expected)
+}
+
+/*
+Requires that a value of the given header of the given response contains the given substring
+*/
+func HeaderContains(t testing.TB, response *http.Response, name string, substring string) {
+	t.Helper()
+	goassert.HeaderContains(fatal(t), response, name, substring)
+}
+
+/*
+Requires that the body of the given response equals the expected body
+*/
+func BodyEqual(t testing.TB, response *http.Response, expected string) {
+	t.Helper()
+	goassert.BodyEqual(fatal(t), response, expected)
+}
+
+/*
+Requires that the body of the given response is a JSON document semantically equal to the expected one
+*/
+func BodyJSONEq(t testing.TB, response *http.Response, expected string) {
+	t.Helper()
+	goassert.BodyJSONEq(fatal(t), response, expected)
+}
+
+/*
+Requires that the body of the given response contains the given substring
+*/
+func BodyContains(t testing.TB, response *http.Response, substring string) {
+	t.Helper()
+	goassert.BodyContains(fatal(t), response, substring)
+}
+
+/*
+Requires that the given handler serves the given request with the expected status code and returns the response
+*/
+func HTTPHandlerReturns(t testing.TB, handler http.Handler, method string, url string, body io.Reader, expectedStatus int) *http.Response {
+	t.Helper()
+	return goassert.HTTPHandlerReturns(fatal(t), handler, method, url, body, expectedStatus)
+}
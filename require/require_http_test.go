@@ -0,0 +1,45 @@
+package require
+
+import (
+	"io"
+	"net/http"
+	"testing"
+)
+
+var mockHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain")
+	io.WriteString(w, "hello")
+})
+
+func Test_HTTPHandlerReturnsShouldContinue_WhenStatusMatches(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		response := HTTPHandlerReturns(t, mockHandler, http.MethodGet, "/", nil, http.StatusOK)
+		HeaderEqual(t, response, "Content-Type", "text/plain")
+		BodyEqual(t, response, "hello")
+	})
+
+	if tester.Failed() || !completed {
+		t.Error("HTTPHandlerReturns did not continue when the status matched")
+	}
+}
+
+func Test_HTTPHandlerReturnsShouldStopTest_WhenStatusDiffers(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		HTTPHandlerReturns(t, mockHandler, http.MethodGet, "/", nil, http.StatusCreated)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("HTTPHandlerReturns did not stop the test when the status differed")
+	}
+}
+
+func Test_BodyContainsShouldStopTest_WhenBodyDoesNotContainSubstring(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		response := HTTPHandlerReturns(t, mockHandler, http.MethodGet, "/", nil, http.StatusOK)
+		BodyContains(t, response, "goodbye")
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("BodyContains did not stop the test when the body did not contain the substring")
+	}
+}
@@ -0,0 +1,31 @@
+package require
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+/*
+Requires that the two given JSON documents are semantically equal
+*/
+func JSONEq(t testing.TB, expected string, actual string) {
+	t.Helper()
+	goassert.JSONEq(fatal(t), expected, actual)
+}
+
+/*
+Requires that the actual JSON document contains the expected JSON document
+*/
+func JSONContains(t testing.TB, expected string, actual string) {
+	t.Helper()
+	goassert.JSONContains(fatal(t), expected, actual)
+}
+
+/*
+Requires that the value at the given path of the given JSON document equals the given expected value
+*/
+func JSONPathEqual(t testing.TB, document string, path string, expected interface{}) {
+	t.Helper()
+	goassert.JSONPathEqual(fatal(t), document, path, expected)
+}
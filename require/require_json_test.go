@@ -0,0 +1,33 @@
+package require
+
+import "testing"
+
+func Test_JSONEqShouldStopTest_GivenDifferentDocuments(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		JSONEq(t, `{"id": 1}`, `{"id": 2}`)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("JSONEq did not stop the test when given different documents")
+	}
+}
+
+func Test_JSONContainsShouldStopTest_WhenExpectedKeyIsMissing(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		JSONContains(t, `{"id": 1}`, `{"name": "ann"}`)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("JSONContains did not stop the test when an expected key was missing")
+	}
+}
+
+func Test_JSONPathEqualShouldStopTest_WhenValueAtPathDiffers(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		JSONPathEqual(t, `{"id": 1}`, "$.id", 2)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("JSONPathEqual did not stop the test when the value at the path differed")
+	}
+}
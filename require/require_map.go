@@ -0,0 +1,87 @@
+package require
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+/*
+Requires that the given map is empty. The requirement fails if the given map is nil
+*/
+func EmptyMap[K comparable, V any](t testing.TB, m map[K]V) {
+	t.Helper()
+	goassert.EmptyMap(fatal(t), m)
+}
+
+/*
+Requires that the given map is not nil or empty
+*/
+func NotEmptyMap[K comparable, V any](t testing.TB, m map[K]V) {
+	t.Helper()
+	goassert.NotEmptyMap(fatal(t), m)
+}
+
+/*
+Requires that the given map has length equal to the specified length
+*/
+func MapLength[K comparable, V any](t testing.TB, m map[K]V, expectedLength int) {
+	t.Helper()
+	goassert.MapLength(fatal(t), m, expectedLength)
+}
+
+/*
+Requires that the given map contains the given key. The key must be [comparable]
+*/
+func MapContainsKey[K comparable, V any](t testing.TB, m map[K]V, k K) {
+	t.Helper()
+	goassert.MapContainsKey(fatal(t), m, k)
+}
+
+/*
+Requires that the given map does not contain the given key. The key must be [comparable]
+*/
+func MapNotContainsKey[K comparable, V any](t testing.TB, m map[K]V, k K) {
+	t.Helper()
+	goassert.MapNotContainsKey(fatal(t), m, k)
+}
+
+/*
+Requires that the given map contains the given key-value pair. The key and value must be [comparable]
+*/
+func MapContains[K, V comparable](t testing.TB, m map[K]V, k K, v V) {
+	t.Helper()
+	goassert.MapContains(fatal(t), m, k, v)
+}
+
+/*
+Requires that the given map does not contain the given key-value pair. The key and value must be [comparable]
+*/
+func MapNotContains[K, V comparable](t testing.TB, m map[K]V, k K, v V) {
+	t.Helper()
+	goassert.MapNotContains(fatal(t), m, k, v)
+}
+
+/*
+Requires that every key-value pair of expected can be found in actual, i.e. expected is a subset of actual
+*/
+func MapSubset[K, V comparable](t testing.TB, expected map[K]V, actual map[K]V) {
+	t.Helper()
+	goassert.MapSubset(fatal(t), expected, actual)
+}
+
+/*
+Requires that the two given maps have exactly the same keys
+*/
+func MapKeysEqual[K comparable, V any](t testing.TB, expected map[K]V, actual map[K]V) {
+	t.Helper()
+	goassert.MapKeysEqual(fatal(t), expected, actual)
+}
+
+/*
+Requires that the given map contains the given key and that its value satisfies the given matcher
+*/
+func MapContainsMatch[K comparable, V any](t testing.TB, m map[K]V, k K, matcher goassert.Matcher[V]) {
+	t.Helper()
+	goassert.MapContainsMatch(fatal(t), m, k, matcher)
+}
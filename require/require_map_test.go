@@ -0,0 +1,117 @@
+package require
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+func Test_EmptyMapShouldStopTest_GivenNilMap(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		EmptyMap[string, int](t, nil)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("EmptyMap did not stop the test when nil map was given")
+	}
+}
+
+func Test_NotEmptyMapShouldStopTest_GivenEmptyMap(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		NotEmptyMap(t, map[string]int{})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("NotEmptyMap did not stop the test when empty map was given")
+	}
+}
+
+func Test_MapLengthShouldStopTest_GivenMapWithDifferentLength(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		MapLength(t, map[string]int{"a": 1}, 2)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("MapLength did not stop the test when map with different length was given")
+	}
+}
+
+func Test_MapContainsKeyShouldContinue_GivenMapWithKey(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		MapContainsKey(t, map[string]int{"a": 1}, "a")
+	})
+
+	if tester.Failed() || !completed {
+		t.Error("MapContainsKey did not continue when map with key was given")
+	}
+}
+
+func Test_MapContainsKeyShouldStopTest_GivenMapWithoutKey(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		MapContainsKey(t, map[string]int{"a": 1}, "b")
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("MapContainsKey did not stop the test when map without key was given")
+	}
+}
+
+func Test_MapNotContainsKeyShouldStopTest_GivenMapWithKey(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		MapNotContainsKey(t, map[string]int{"a": 1}, "a")
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("MapNotContainsKey did not stop the test when map with key was given")
+	}
+}
+
+func Test_MapContainsShouldStopTest_GivenMapWithDifferentValue(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		MapContains(t, map[string]int{"a": 1}, "a", 2)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("MapContains did not stop the test when map with different value was given")
+	}
+}
+
+func Test_MapNotContainsShouldStopTest_GivenMapWithKeyValuePair(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		MapNotContains(t, map[string]int{"a": 1}, "a", 1)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("MapNotContains did not stop the test when map with key-value pair was given")
+	}
+}
+
+func Test_MapSubsetShouldStopTest_WhenExpectedPairsAreNotInActual(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		MapSubset(t, map[string]int{"a": 1}, map[string]int{"a": 2})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("MapSubset did not stop the test when expected key-value pairs were not in actual")
+	}
+}
+
+func Test_MapKeysEqualShouldStopTest_GivenMapsWithDifferentKeys(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		MapKeysEqual(t, map[string]int{"a": 1}, map[string]int{"b": 1})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("MapKeysEqual did not stop the test when given maps with different keys")
+	}
+}
+
+func Test_MapContainsMatchShouldStopTest_GivenKeyWithMismatchingValue(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		MapContainsMatch(t, map[string]int{"a": 1}, "a", goassert.GreaterThan(1))
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("MapContainsMatch did not stop the test when the value of the key did not match")
+	}
+}
@@ -0,0 +1,23 @@
+package require
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+/*
+Requires that the actual value matches the fields that are set in the expected value
+*/
+func MatchFields[T any](t testing.TB, expected T, actual T) {
+	t.Helper()
+	goassert.MatchFields(fatal(t), expected, actual)
+}
+
+/*
+Requires that the fields of the given value at the given paths equal the expected values
+*/
+func MatchMap(t testing.TB, expected map[string]interface{}, actual interface{}) {
+	t.Helper()
+	goassert.MatchMap(fatal(t), expected, actual)
+}
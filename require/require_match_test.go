@@ -0,0 +1,38 @@
+package require
+
+import "testing"
+
+type mockPerson struct {
+	Name string
+	Age  int
+}
+
+func Test_MatchFieldsShouldContinue_GivenMatchingSetFields(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		MatchFields(t, mockPerson{Name: "Ann"}, mockPerson{Name: "Ann", Age: 30})
+	})
+
+	if tester.Failed() || !completed {
+		t.Error("MatchFields did not continue when the set fields matched")
+	}
+}
+
+func Test_MatchFieldsShouldStopTest_GivenMismatchingSetFields(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		MatchFields(t, mockPerson{Name: "Bob"}, mockPerson{Name: "Ann", Age: 30})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("MatchFields did not stop the test when the set fields did not match")
+	}
+}
+
+func Test_MatchMapShouldStopTest_GivenMismatchingFields(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		MatchMap(t, map[string]interface{}{"Age": 31}, mockPerson{Name: "Ann", Age: 30})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("MatchMap did not stop the test when the fields did not match")
+	}
+}
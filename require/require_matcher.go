@@ -0,0 +1,15 @@
+package require
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+/*
+Requires that the given value satisfies the given matcher
+*/
+func That[T any](t testing.TB, actual T, matcher goassert.Matcher[T]) {
+	t.Helper()
+	goassert.That(fatal(t), actual, matcher)
+}
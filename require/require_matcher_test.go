@@ -0,0 +1,27 @@
+package require
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+func Test_ThatShouldContinue_GivenSatisfiedMatcher(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		That(t, 3, goassert.GreaterThan(2))
+	})
+
+	if tester.Failed() || !completed {
+		t.Error("That did not continue when the matcher was satisfied")
+	}
+}
+
+func Test_ThatShouldStopTest_GivenUnsatisfiedMatcher(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		That(t, 3, goassert.Not(goassert.Eq(3)))
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("That did not stop the test when the matcher was not satisfied")
+	}
+}
@@ -0,0 +1,55 @@
+package require
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+/*
+Requires that the difference between the two given numbers is at most the given delta
+*/
+func InDelta[N goassert.Number](t testing.TB, expected N, actual N, delta float64) {
+	t.Helper()
+	goassert.InDelta(fatal(t), expected, actual, delta)
+}
+
+/*
+Requires that the relative error between the two given numbers is at most the given epsilon
+*/
+func InEpsilon[N goassert.Number](t testing.TB, expected N, actual N, epsilon float64) {
+	t.Helper()
+	goassert.InEpsilon(fatal(t), expected, actual, epsilon)
+}
+
+/*
+Requires that the two given floats are at most maxULPs units in the last place apart
+*/
+func WithinULPs[F goassert.Float](t testing.TB, expected F, actual F, maxULPs uint64) {
+	t.Helper()
+	goassert.WithinULPs(fatal(t), expected, actual, maxULPs)
+}
+
+/*
+Requires that the two given floats are exactly equal, considering NaN equal to NaN
+*/
+func EqualFloat[F goassert.Float](t testing.TB, expected F, actual F) {
+	t.Helper()
+	goassert.EqualFloat(fatal(t), expected, actual)
+}
+
+/*
+Requires that every element of actual is within the given delta of the element at the same index of expected
+*/
+func SliceInDelta[N goassert.Number](t testing.TB, expected []N, actual []N, delta float64) {
+	t.Helper()
+	goassert.SliceInDelta(fatal(t), expected, actual, delta)
+}
+
+/*
+Requires that every value of actual is within the given delta of the value for the same key in expected
+*/
+func MapInDelta[K comparable, N goassert.Number](t testing.TB, expected map[K]N, actual map[K]N, delta float64) {
+	t.Helper()
+	goassert.MapInDelta(fatal(t), expected, actual, delta)
+}
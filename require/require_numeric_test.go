@@ -0,0 +1,76 @@
+package require
+
+import (
+	"math"
+	"testing"
+)
+
+func Test_InDeltaShouldContinue_WhenDifferenceIsWithinDelta(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		InDelta(t, 1.0, 1.01, 0.05)
+	})
+
+	if tester.Failed() || !completed {
+		t.Error("InDelta did not continue when the difference was within delta")
+	}
+}
+
+func Test_InDeltaShouldStopTest_WhenDifferenceExceedsDelta(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		InDelta(t, 1.0, 1.1, 0.05)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("InDelta did not stop the test when the difference exceeded delta")
+	}
+}
+
+func Test_InEpsilonShouldStopTest_WhenRelativeErrorExceedsEpsilon(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		InEpsilon(t, 10.0, 11.0, 0.01)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("InEpsilon did not stop the test when the relative error exceeded epsilon")
+	}
+}
+
+func Test_WithinULPsShouldStopTest_WhenFloatsAreTooFarApart(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		WithinULPs(t, 1.0, 1.001, 4)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("WithinULPs did not stop the test when the floats were too far apart")
+	}
+}
+
+func Test_EqualFloatShouldStopTest_GivenNaNAndNumber(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		EqualFloat(t, math.NaN(), 1.0)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("EqualFloat did not stop the test when given NaN and a number")
+	}
+}
+
+func Test_SliceInDeltaShouldStopTest_WhenElementExceedsDelta(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		SliceInDelta(t, []float64{1, 2}, []float64{1, 2.5}, 0.1)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("SliceInDelta did not stop the test when an element exceeded delta")
+	}
+}
+
+func Test_MapInDeltaShouldStopTest_WhenValueExceedsDelta(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		MapInDelta(t, map[string]float64{"a": 1}, map[string]float64{"a": 1.5}, 0.1)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("MapInDelta did not stop the test when a value exceeded delta")
+	}
+}
@@ -0,0 +1,63 @@
+package require
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+/*
+Requires that the first given value is greater than the second given value
+*/
+func Greater[T goassert.Ordered](t testing.TB, a T, b T) {
+	t.Helper()
+	goassert.Greater(fatal(t), a, b)
+}
+
+/*
+Requires that the first given value is greater than or equal to the second given value
+*/
+func GreaterOrEqual[T goassert.Ordered](t testing.TB, a T, b T) {
+	t.Helper()
+	goassert.GreaterOrEqual(fatal(t), a, b)
+}
+
+/*
+Requires that the first given value is less than the second given value
+*/
+func Less[T goassert.Ordered](t testing.TB, a T, b T) {
+	t.Helper()
+	goassert.Less(fatal(t), a, b)
+}
+
+/*
+Requires that the first given value is less than or equal to the second given value
+*/
+func LessOrEqual[T goassert.Ordered](t testing.TB, a T, b T) {
+	t.Helper()
+	goassert.LessOrEqual(fatal(t), a, b)
+}
+
+/*
+Requires that the given value is within the given inclusive bounds
+*/
+func Between[T goassert.Ordered](t testing.TB, actual T, lower T, upper T) {
+	t.Helper()
+	goassert.Between(fatal(t), actual, lower, upper)
+}
+
+/*
+Requires that the given number is greater than zero
+*/
+func Positive[N goassert.Number](t testing.TB, actual N) {
+	t.Helper()
+	goassert.Positive(fatal(t), actual)
+}
+
+/*
+Requires that the given number is less than zero
+*/
+func Negative[N goassert.Number](t testing.TB, actual N) {
+	t.Helper()
+	goassert.Negative(fatal(t), actual)
+}
@@ -0,0 +1,93 @@
+package require
+
+import "testing"
+
+func Test_GreaterShouldContinue_WhenFirstValueIsGreater(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		Greater(t, 16, 10)
+	})
+
+	if tester.Failed() || !completed {
+		t.Error("Greater did not continue when the first value was greater")
+	}
+}
+
+func Test_GreaterShouldStopTest_WhenFirstValueIsLess(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		Greater(t, 5, 10)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("Greater did not stop the test when the first value was less")
+	}
+}
+
+func Test_GreaterOrEqualShouldStopTest_WhenFirstValueIsLess(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		GreaterOrEqual(t, 5, 10)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("GreaterOrEqual did not stop the test when the first value was less")
+	}
+}
+
+func Test_LessShouldStopTest_WhenFirstValueIsGreater(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		Less(t, 16, 10)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("Less did not stop the test when the first value was greater")
+	}
+}
+
+func Test_LessOrEqualShouldStopTest_WhenFirstValueIsGreater(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		LessOrEqual(t, 16, 10)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("LessOrEqual did not stop the test when the first value was greater")
+	}
+}
+
+func Test_BetweenShouldStopTest_WhenValueIsOutOfBounds(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		Between(t, 16, 5, 10)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("Between did not stop the test when the value was out of bounds")
+	}
+}
+
+func Test_PositiveShouldStopTest_GivenNegativeNumber(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		Positive(t, -1)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("Positive did not stop the test when given negative number")
+	}
+}
+
+func Test_NegativeShouldStopTest_GivenPositiveNumber(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		Negative(t, 1)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("Negative did not stop the test when given positive number")
+	}
+}
+
+func Test_ZeroShouldStopTest_GivenNonZeroNumber(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		Zero(t, 1)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("Zero did not stop the test when given non-zero number")
+	}
+}
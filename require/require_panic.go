@@ -0,0 +1,73 @@
+package require
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+/*
+Requires that the given function panics
+*/
+func Panic(t testing.TB, underTest func()) {
+	t.Helper()
+	goassert.Panic(fatal(t), underTest)
+}
+
+/*
+Requires that the given function does not panic
+*/
+func NotPanic(t testing.TB, underTest func()) {
+	t.Helper()
+	goassert.NotPanic(fatal(t), underTest)
+}
+
+/*
+Requires that the given function panics with the specified error.
+The actual error must be of the same type and value as the given error
+*/
+func PanicWithError[T any](t testing.TB, expectedError T, underTest func()) {
+	t.Helper()
+	goassert.PanicWithError(fatal(t), expectedError, underTest)
+}
+
+/*
+Requires that the given function does not panic with the specified error.
+The requirement succeeds if the given function does not panic or panics with a different error
+*/
+func NotPanicWithError[T any](t testing.TB, expectedError T, underTest func()) {
+	t.Helper()
+	goassert.NotPanicWithError(fatal(t), expectedError, underTest)
+}
+
+/*
+Requires that the given function panics with a message containing the given substring
+*/
+func PanicsWithMessage(t testing.TB, substring string, underTest func()) {
+	t.Helper()
+	goassert.PanicsWithMessage(fatal(t), substring, underTest)
+}
+
+/*
+Requires that the given function panics with an error matching the given target. Internally uses errors.Is
+*/
+func PanicsWithErrorIs(t testing.TB, target error, underTest func()) {
+	t.Helper()
+	goassert.PanicsWithErrorIs(fatal(t), target, underTest)
+}
+
+/*
+Requires that the given function panics with a value for which the given function returns true
+*/
+func PanicsMatching(t testing.TB, matches func(recovered interface{}) bool, underTest func()) {
+	t.Helper()
+	goassert.PanicsMatching(fatal(t), matches, underTest)
+}
+
+/*
+Requires that the given function panics and returns the recovered value for further assertions
+*/
+func RecoverPanic(t testing.TB, underTest func()) interface{} {
+	t.Helper()
+	return goassert.RecoverPanic(fatal(t), underTest)
+}
@@ -0,0 +1,121 @@
+package require
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_PanicShouldStopTest_WhenGivenFuncDoesNotPanic(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		Panic(t, func() {})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("Panic did not stop the test when the given func did not panic")
+	}
+}
+
+func Test_NotPanicShouldContinue_WhenGivenFuncDoesNotPanic(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		NotPanic(t, func() {})
+	})
+
+	if tester.Failed() || !completed {
+		t.Error("NotPanic did not continue when the given func did not panic")
+	}
+}
+
+func Test_NotPanicShouldStopTest_WhenGivenFuncPanics(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		NotPanic(t, func() {
+			panic("Error")
+		})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("NotPanic did not stop the test when the given func panicked")
+	}
+}
+
+func Test_PanicWithErrorShouldStopTest_WhenGivenFuncPanicsWithDifferentError(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		PanicWithError(t, "Error", func() {
+			panic("Different Error")
+		})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("PanicWithError did not stop the test when the given func panicked with different error")
+	}
+}
+
+func Test_NotPanicWithErrorShouldStopTest_WhenGivenFuncPanicsWithGivenError(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		NotPanicWithError(t, "Error", func() {
+			panic("Error")
+		})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("NotPanicWithError did not stop the test when the given func panicked with given error")
+	}
+}
+
+func Test_PanicsWithMessageShouldStopTest_WhenGivenFuncPanicsWithDifferentMessage(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		PanicsWithMessage(t, "timeout", func() {
+			panic("connection refused")
+		})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("PanicsWithMessage did not stop the test when the given func panicked with a different message")
+	}
+}
+
+func Test_PanicsWithErrorIsShouldStopTest_WhenGivenFuncPanicsWithDifferentError(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		PanicsWithErrorIs(t, errors.New("not found"), func() {
+			panic(errors.New("permission denied"))
+		})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("PanicsWithErrorIs did not stop the test when the given func panicked with a different error")
+	}
+}
+
+func Test_PanicsMatchingShouldStopTest_WhenRecoveredValueDoesNotMatch(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		PanicsMatching(t, func(interface{}) bool { return false }, func() {
+			panic("Error")
+		})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("PanicsMatching did not stop the test when the recovered value did not match")
+	}
+}
+
+func Test_RecoverPanicShouldReturnRecoveredValue_WhenGivenFuncPanics(t *testing.T) {
+	var recovered interface{}
+	tester, completed := runRequirement(func(t testing.TB) {
+		recovered = RecoverPanic(t, func() {
+			panic("Error")
+		})
+	})
+
+	if tester.Failed() || !completed || recovered != "Error" {
+		t.Errorf("RecoverPanic did not return the recovered value but got %v", recovered)
+	}
+}
+
+func Test_RecoverPanicShouldStopTest_WhenGivenFuncDoesNotPanic(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		RecoverPanic(t, func() {})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("RecoverPanic did not stop the test when the given func did not panic")
+	}
+}
@@ -0,0 +1,31 @@
+package require
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+/*
+Requires that the two given pointers point to the same value
+*/
+func Same[T any](t testing.TB, expected *T, actual *T) {
+	t.Helper()
+	goassert.Same(fatal(t), expected, actual)
+}
+
+/*
+Requires that the two given pointers do not point to the same value
+*/
+func NotSame[T any](t testing.TB, expected *T, actual *T) {
+	t.Helper()
+	goassert.NotSame(fatal(t), expected, actual)
+}
+
+/*
+Requires that the given pointer is not nil and that the value it points to is deeply equal to the expected value
+*/
+func PointeeEqual[T any](t testing.TB, expected T, ptr *T) {
+	t.Helper()
+	goassert.PointeeEqual(fatal(t), expected, ptr)
+}
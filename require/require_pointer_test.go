@@ -0,0 +1,35 @@
+package require
+
+import "testing"
+
+func Test_SameShouldContinue_GivenPointersToSameValue(t *testing.T) {
+	value := 42
+	tester, completed := runRequirement(func(t testing.TB) {
+		Same(t, &value, &value)
+	})
+
+	if tester.Failed() || !completed {
+		t.Error("Same did not continue when the pointers pointed to the same value")
+	}
+}
+
+func Test_SameShouldStopTest_GivenPointersToDistinctValues(t *testing.T) {
+	first, second := 42, 42
+	tester, completed := runRequirement(func(t testing.TB) {
+		Same(t, &first, &second)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("Same did not stop the test when the pointers pointed to distinct values")
+	}
+}
+
+func Test_PointeeEqualShouldStopTest_GivenNilPointer(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		PointeeEqual(t, 42, nil)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("PointeeEqual did not stop the test when the pointer was nil")
+	}
+}
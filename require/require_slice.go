@@ -0,0 +1,127 @@
+package require
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+/*
+Requires that the given slice is empty. The requirement fails if the given slice is nil
+*/
+func EmptySlice[T any](t testing.TB, s []T) {
+	t.Helper()
+	goassert.EmptySlice(fatal(t), s)
+}
+
+/*
+Requires that the given slice is not nil or empty
+*/
+func NotEmptySlice[T any](t testing.TB, s []T) {
+	t.Helper()
+	goassert.NotEmptySlice(fatal(t), s)
+}
+
+/*
+Requires that the given slice has length equal to the specified expected length
+*/
+func SliceLength[T any](t testing.TB, s []T, expectedLength int) {
+	t.Helper()
+	goassert.SliceLength(fatal(t), s, expectedLength)
+}
+
+/*
+Requires that the given slice contains the given element. The element must be [comparable]
+*/
+func SliceContains[K comparable](t testing.TB, s []K, element K) {
+	t.Helper()
+	goassert.SliceContains(fatal(t), s, element)
+}
+
+/*
+Requires that the given slice does not contain the given element. The element must be [comparable]
+*/
+func SliceNotContains[K comparable](t testing.TB, s []K, element K) {
+	t.Helper()
+	goassert.SliceNotContains(fatal(t), s, element)
+}
+
+/*
+Requires that the elements of the given slice are in non-decreasing order
+*/
+func SliceSorted[T goassert.Ordered](t testing.TB, s []T) {
+	t.Helper()
+	goassert.SliceSorted(fatal(t), s)
+}
+
+/*
+Requires that the elements of the given slice are sorted according to the given less function
+*/
+func SliceSortedFunc[T any](t testing.TB, s []T, less func(a, b T) bool) {
+	t.Helper()
+	goassert.SliceSortedFunc(fatal(t), s, less)
+}
+
+/*
+Requires that the keys extracted from the elements of the given slice with the given function are in non-decreasing order
+*/
+func SliceSortedBy[T any, K goassert.Ordered](t testing.TB, s []T, key func(T) K) {
+	t.Helper()
+	goassert.SliceSortedBy(fatal(t), s, key)
+}
+
+/*
+Requires that every element of the given slice is strictly less than the element following it
+*/
+func SliceStrictlyIncreasing[T goassert.Ordered](t testing.TB, s []T) {
+	t.Helper()
+	goassert.SliceStrictlyIncreasing(fatal(t), s)
+}
+
+/*
+Requires that the elements of the given slice are in non-increasing order
+*/
+func SliceDecreasing[T goassert.Ordered](t testing.TB, s []T) {
+	t.Helper()
+	goassert.SliceDecreasing(fatal(t), s)
+}
+
+/*
+Requires that every element of expected can be found in actual, i.e. expected is a subset of actual
+*/
+func SliceSubset[K comparable](t testing.TB, expected []K, actual []K) {
+	t.Helper()
+	goassert.SliceSubset(fatal(t), expected, actual)
+}
+
+/*
+Requires that every element of actual can be found in expected, i.e. expected is a superset of actual
+*/
+func SliceSuperset[K comparable](t testing.TB, expected []K, actual []K) {
+	t.Helper()
+	goassert.SliceSuperset(fatal(t), expected, actual)
+}
+
+/*
+Requires that the two given slices have no elements in common
+*/
+func SliceDisjoint[K comparable](t testing.TB, a []K, b []K) {
+	t.Helper()
+	goassert.SliceDisjoint(fatal(t), a, b)
+}
+
+/*
+Requires that the given slice contains at least one element satisfying the given matcher
+*/
+func SliceContainsMatch[T any](t testing.TB, s []T, matcher goassert.Matcher[T]) {
+	t.Helper()
+	goassert.SliceContainsMatch(fatal(t), s, matcher)
+}
+
+/*
+Requires that the given slice does not contain any element satisfying the given matcher
+*/
+func SliceNotContainsMatch[T any](t testing.TB, s []T, matcher goassert.Matcher[T]) {
+	t.Helper()
+	goassert.SliceNotContainsMatch(fatal(t), s, matcher)
+}
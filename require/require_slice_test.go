@@ -0,0 +1,167 @@
+package require
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+func Test_EmptySliceShouldStopTest_GivenNonEmptySlice(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		EmptySlice(t, []int{10})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("EmptySlice did not stop the test when non-empty slice was given")
+	}
+}
+
+func Test_NotEmptySliceShouldStopTest_GivenNilSlice(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		NotEmptySlice[int](t, nil)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("NotEmptySlice did not stop the test when nil slice was given")
+	}
+}
+
+func Test_SliceLengthShouldStopTest_GivenSliceWithDifferentLength(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		SliceLength(t, []int{10}, 2)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("SliceLength did not stop the test when slice with different length was given")
+	}
+}
+
+func Test_SliceContainsShouldContinue_GivenSliceWithElement(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		SliceContains(t, []int{10, 16}, 16)
+	})
+
+	if tester.Failed() || !completed {
+		t.Error("SliceContains did not continue when slice with element was given")
+	}
+}
+
+func Test_SliceContainsShouldStopTest_GivenSliceWithoutElement(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		SliceContains(t, []int{10, 16}, 5)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("SliceContains did not stop the test when slice without element was given")
+	}
+}
+
+func Test_SliceNotContainsShouldStopTest_GivenSliceWithElement(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		SliceNotContains(t, []int{10, 16}, 16)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("SliceNotContains did not stop the test when slice with element was given")
+	}
+}
+
+func Test_SliceSortedShouldStopTest_GivenUnsortedSlice(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		SliceSorted(t, []int{10, 3})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("SliceSorted did not stop the test when unsorted slice was given")
+	}
+}
+
+func Test_SliceSortedFuncShouldStopTest_GivenSliceNotSortedByLessFunc(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		SliceSortedFunc(t, []int{3, 10}, func(a, b int) bool { return a > b })
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("SliceSortedFunc did not stop the test when slice not sorted by the less func was given")
+	}
+}
+
+func Test_SliceSortedByShouldStopTest_GivenSliceNotSortedByKey(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		SliceSortedBy(t, []string{"ccc", "a"}, func(s string) int { return len(s) })
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("SliceSortedBy did not stop the test when slice not sorted by key was given")
+	}
+}
+
+func Test_SliceStrictlyIncreasingShouldStopTest_GivenSliceWithDuplicates(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		SliceStrictlyIncreasing(t, []int{3, 3})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("SliceStrictlyIncreasing did not stop the test when slice with duplicates was given")
+	}
+}
+
+func Test_SliceDecreasingShouldStopTest_GivenIncreasingSlice(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		SliceDecreasing(t, []int{3, 10})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("SliceDecreasing did not stop the test when increasing slice was given")
+	}
+}
+
+func Test_SliceSubsetShouldStopTest_WhenExpectedElementsAreNotInActual(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		SliceSubset(t, []int{3, 7}, []int{3, 10})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("SliceSubset did not stop the test when expected elements were not in actual")
+	}
+}
+
+func Test_SliceSupersetShouldStopTest_WhenActualElementsAreNotInExpected(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		SliceSuperset(t, []int{3}, []int{3, 10})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("SliceSuperset did not stop the test when actual elements were not in expected")
+	}
+}
+
+func Test_SliceDisjointShouldStopTest_GivenSlicesWithCommonElements(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		SliceDisjoint(t, []int{3, 10}, []int{10})
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("SliceDisjoint did not stop the test when given slices with common elements")
+	}
+}
+
+func Test_SliceContainsMatchShouldStopTest_GivenSliceWithoutMatchingElement(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		SliceContainsMatch(t, []int{1, 2}, goassert.GreaterThan(2))
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("SliceContainsMatch did not stop the test when the slice did not contain a matching element")
+	}
+}
+
+func Test_SliceNotContainsMatchShouldStopTest_GivenSliceWithMatchingElement(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		SliceNotContainsMatch(t, []int{1, 3}, goassert.GreaterThan(2))
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("SliceNotContainsMatch did not stop the test when the slice contained a matching element")
+	}
+}
@@ -0,0 +1,80 @@
+package require
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+/*
+Requires that the given string contains the given substring
+*/
+func StringContains(t testing.TB, s string, substring string) {
+	t.Helper()
+	goassert.StringContains(fatal(t), s, substring)
+}
+
+/*
+Requires that the given string does not contain the given substring
+*/
+func StringNotContains(t testing.TB, s string, substring string) {
+	t.Helper()
+	goassert.StringNotContains(fatal(t), s, substring)
+}
+
+/*
+Requires that the given string starts with the given prefix
+*/
+func HasPrefix(t testing.TB, s string, prefix string) {
+	t.Helper()
+	goassert.HasPrefix(fatal(t), s, prefix)
+}
+
+/*
+Requires that the given string ends with the given suffix
+*/
+func HasSuffix(t testing.TB, s string, suffix string) {
+	t.Helper()
+	goassert.HasSuffix(fatal(t), s, suffix)
+}
+
+/*
+Requires that the given string matches the given regular expression, given as a pattern string or as a compiled *regexp.Regexp
+*/
+func Regexp[P string | *regexp.Regexp](t testing.TB, s string, pattern P) {
+	t.Helper()
+	goassert.Regexp(fatal(t), s, pattern)
+}
+
+/*
+Requires that the given string does not match the given regular expression, given as a pattern string or as a compiled *regexp.Regexp
+*/
+func NotRegexp[P string | *regexp.Regexp](t testing.TB, s string, pattern P) {
+	t.Helper()
+	goassert.NotRegexp(fatal(t), s, pattern)
+}
+
+/*
+Requires that the two given strings are equal under simple Unicode case-folding
+*/
+func EqualFold(t testing.TB, expected string, actual string) {
+	t.Helper()
+	goassert.EqualFold(fatal(t), expected, actual)
+}
+
+/*
+Requires that the given string has the given length, counted in runes rather than bytes
+*/
+func StringLength(t testing.TB, s string, expectedLength int) {
+	t.Helper()
+	goassert.StringLength(fatal(t), s, expectedLength)
+}
+
+/*
+Requires that the two given texts are equal after applying the given options
+*/
+func EqualText(t testing.TB, expected string, actual string, options ...goassert.TextOption) {
+	t.Helper()
+	goassert.EqualText(fatal(t), expected, actual, options...)
+}
@@ -0,0 +1,113 @@
+package require
+
+import "testing"
+
+func Test_StringContainsShouldContinue_WhenStringContainsSubstring(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		StringContains(t, "hello world", "world")
+	})
+
+	if tester.Failed() || !completed {
+		t.Error("StringContains did not continue when the string contained the substring")
+	}
+}
+
+func Test_StringContainsShouldStopTest_WhenStringDoesNotContainSubstring(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		StringContains(t, "hello world", "planet")
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("StringContains did not stop the test when the string did not contain the substring")
+	}
+}
+
+func Test_StringNotContainsShouldStopTest_WhenStringContainsSubstring(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		StringNotContains(t, "hello world", "world")
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("StringNotContains did not stop the test when the string contained the substring")
+	}
+}
+
+func Test_HasPrefixShouldStopTest_WhenStringDoesNotStartWithPrefix(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		HasPrefix(t, "hello", "world")
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("HasPrefix did not stop the test when the string did not start with the prefix")
+	}
+}
+
+func Test_HasSuffixShouldStopTest_WhenStringDoesNotEndWithSuffix(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		HasSuffix(t, "hello", "world")
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("HasSuffix did not stop the test when the string did not end with the suffix")
+	}
+}
+
+func Test_RegexpShouldStopTest_GivenNonMatchingPattern(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		Regexp(t, "hello", `^\d+$`)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("Regexp did not stop the test when given a non-matching pattern")
+	}
+}
+
+func Test_NotRegexpShouldStopTest_GivenMatchingPattern(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		NotRegexp(t, "hello", `l+`)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("NotRegexp did not stop the test when given a matching pattern")
+	}
+}
+
+func Test_EqualFoldShouldStopTest_GivenDifferentStrings(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		EqualFold(t, "Go", "Gopher")
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("EqualFold did not stop the test when given different strings")
+	}
+}
+
+func Test_StringLengthShouldStopTest_GivenStringWithDifferentLength(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		StringLength(t, "hello", 4)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("StringLength did not stop the test when given a string with different length")
+	}
+}
+
+func Test_EqualTextShouldContinue_GivenEqualTexts(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		EqualText(t, "line 1\nline 2", "line 1\nline 2")
+	})
+
+	if tester.Failed() || !completed {
+		t.Error("EqualText did not continue when the texts were equal")
+	}
+}
+
+func Test_EqualTextShouldStopTest_GivenDifferentTexts(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		EqualText(t, "line 1\nline 2", "line 1\nline 3")
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("EqualText did not stop the test when the texts were different")
+	}
+}
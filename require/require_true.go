@@ -0,0 +1,23 @@
+package require
+
+import (
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+/*
+Requires that the given value is true
+*/
+func True(t testing.TB, assertion bool) {
+	t.Helper()
+	goassert.True(fatal(t), assertion)
+}
+
+/*
+Requires that the given value is false
+*/
+func False(t testing.TB, assertion bool) {
+	t.Helper()
+	goassert.False(fatal(t), assertion)
+}
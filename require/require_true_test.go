@@ -0,0 +1,33 @@
+package require
+
+import "testing"
+
+func Test_TrueShouldContinue_GivenTrueAssertion(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		True(t, true)
+	})
+
+	if tester.Failed() || !completed {
+		t.Error("True did not continue given true assertion")
+	}
+}
+
+func Test_TrueShouldStopTest_GivenFalseAssertion(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		True(t, false)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("True did not stop the test given false assertion")
+	}
+}
+
+func Test_FalseShouldStopTest_GivenTrueAssertion(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		False(t, true)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("False did not stop the test given true assertion")
+	}
+}
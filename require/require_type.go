@@ -0,0 +1,48 @@
+package require
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golanglibs/goassert"
+)
+
+/*
+Requires that the given value is of type T, or implements T if T is an interface, and returns it as a T
+*/
+func IsType[T any](t testing.TB, value interface{}) T {
+	t.Helper()
+	return goassert.IsType[T](fatal(t), value)
+}
+
+/*
+Requires that the given value implements the interface I
+*/
+func Implements[I any](t testing.TB, value interface{}) {
+	t.Helper()
+	goassert.Implements[I](fatal(t), value)
+}
+
+/*
+Requires that the two given values have the same dynamic type
+*/
+func SameType(t testing.TB, expected interface{}, actual interface{}) {
+	t.Helper()
+	goassert.SameType(fatal(t), expected, actual)
+}
+
+/*
+Requires that the given value is of the given kind
+*/
+func Kind(t testing.TB, value interface{}, expected reflect.Kind) {
+	t.Helper()
+	goassert.Kind(fatal(t), value, expected)
+}
+
+/*
+Requires that the given value is assignable to a variable of type T
+*/
+func AssignableTo[T any](t testing.TB, value interface{}) {
+	t.Helper()
+	goassert.AssignableTo[T](fatal(t), value)
+}
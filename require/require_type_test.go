@@ -0,0 +1,37 @@
+package require
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_IsTypeShouldContinueAndReturnValue_GivenValueOfType(t *testing.T) {
+	var value int
+	tester, completed := runRequirement(func(t testing.TB) {
+		value = IsType[int](t, 42)
+	})
+
+	if tester.Failed() || !completed || value != 42 {
+		t.Error("IsType did not continue and return the value when the value was of the type")
+	}
+}
+
+func Test_IsTypeShouldStopTest_GivenValueOfDifferentType(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		IsType[string](t, 42)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("IsType did not stop the test when the value was of a different type")
+	}
+}
+
+func Test_KindShouldStopTest_GivenValueOfDifferentKind(t *testing.T) {
+	tester, completed := runRequirement(func(t testing.TB) {
+		Kind(t, 42, reflect.String)
+	})
+
+	if !tester.Failed() || completed {
+		t.Error("Kind did not stop the test when the value was of a different kind")
+	}
+}
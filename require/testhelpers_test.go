@@ -0,0 +1,53 @@
+package require
+
+import "testing"
+
+/*
+Runs the given function on its own goroutine so that a t.Fatal call stops only the function under test.
+Returns the tester used and whether the function ran to completion
+*/
+func runRequirement(underTest func(t testing.TB)) (*testing.T, bool) {
+	tester := new(testing.T)
+	completed := false
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		underTest(tester)
+		completed = true
+	}()
+	<-done
+
+	return tester, completed
+}
+
+type cleanupT struct {
+	*testing.T
+	cleanups []func()
+}
+
+func (c *cleanupT) Cleanup(f func()) {
+	c.cleanups = append(c.cleanups, f)
+}
+
+/*
+Runs the given function, then the cleanups it registered on their own goroutine, like [runRequirement].
+Returns the tester used and whether the cleanups ran to completion
+*/
+func runRequirementCleanups(underTest func(t testing.TB)) (*testing.T, bool) {
+	tester := &cleanupT{T: new(testing.T)}
+	underTest(tester)
+	completed := false
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := len(tester.cleanups) - 1; i >= 0; i-- {
+			tester.cleanups[i]()
+		}
+		completed = true
+	}()
+	<-done
+
+	return tester.T, completed
+}
@@ -1,5 +1,11 @@
 package goassert
 
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
 type mockStruct struct {
 	Prop int
 }
@@ -9,3 +15,50 @@ func newMockStruct(prop int) *mockStruct {
 		Prop: prop,
 	}
 }
+
+type recordingT struct {
+	*testing.T
+	name     string
+	messages []string
+	cleanups []func()
+}
+
+func newRecordingT() *recordingT {
+	return &recordingT{T: new(testing.T)}
+}
+
+func (r *recordingT) Error(args ...interface{}) {
+	r.messages = append(r.messages, fmt.Sprint(args...))
+	r.T.Fail()
+}
+
+func (r *recordingT) Errorf(format string, args ...interface{}) {
+	r.messages = append(r.messages, fmt.Sprintf(format, args...))
+	r.T.Fail()
+}
+
+func (r *recordingT) Name() string {
+	return r.name
+}
+
+func (r *recordingT) Cleanup(f func()) {
+	r.cleanups = append(r.cleanups, f)
+}
+
+func (r *recordingT) runCleanups() {
+	for i := len(r.cleanups) - 1; i >= 0; i-- {
+		r.cleanups[i]()
+	}
+}
+
+func (r *recordingT) output() string {
+	return strings.Join(r.messages, "\n")
+}
+
+type mockError struct {
+	Code int
+}
+
+func (e *mockError) Error() string {
+	return fmt.Sprintf("mock error %d", e.Code)
+}